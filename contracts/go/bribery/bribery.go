@@ -0,0 +1,265 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bribery simulates the cross-chain bribery attack on HTLC swaps.
+//
+// Alice and Bob swap funds across two chains. Alice locks Value for Bob on
+// chain A and Bob locks funds for Alice on chain B with a shorter timelock.
+// Alice claims on chain B, which reveals the secret, and Bob uses it to claim
+// on chain A. As the briber, Alice pays the rational members of chain A's
+// committee to leave Bob's claim out of their blocks until the timelock of
+// chain A has passed, so that her refund succeeds and she ends up with both
+// sides of the swap.
+package bribery
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// ChainConfig describes one chain and its committee
+type ChainConfig struct {
+	BlockInterval time.Duration // mean time between blocks
+	Jitter        time.Duration // blocks come up to Jitter earlier or later
+	Committee     int           // number of committee members
+	Rational      int           // members that take a bribe exceeding their fee
+	Quorum        int           // members needed to include a request in a block
+	Fee           uint64        // mean fee a member earns for including a request
+}
+
+// Config describes the swap, the attack and how often to run it
+type Config struct {
+	ChainA        ChainConfig   // chain where the victim claims
+	ChainB        ChainConfig   // chain where the briber claims and reveals the secret
+	Value         uint64        // iotas escrowed for the victim on chain A
+	TimelockA     time.Duration // timelock of the swap on chain A
+	TimelockB     time.Duration // timelock of the swap on chain B
+	ReactionDelay time.Duration // time the victim needs to claim after the secret is revealed
+	Bribe         uint64        // paid to each bribed member per block, zero disables the attack
+	Budget        uint64        // most the briber pays in total, zero means Value
+	Runs          int
+	Seed          int64
+}
+
+// Outcome is the result of a single run, all times are since the swap was
+// set up
+type Outcome struct {
+	Run            int
+	SecretRevealed time.Duration // block time of the briber's claim on chain B
+	ClaimSubmitted time.Duration // time the victim submitted its claim on chain A
+	ClaimIncluded  time.Duration // block time of the victim's claim, zero if it never made it
+	RefundIncluded time.Duration // block time of the briber's refund, zero if it never made it
+	VictimPaid     bool          // the victim claimed its funds on chain A
+	BriberRefunded bool          // the briber got its escrow back, the attack succeeded
+	BribedBlocks   int           // blocks the victim's claim was censored in
+	BribeCost      uint64        // bribes paid in total
+}
+
+// Summary aggregates the outcomes of several runs
+type Summary struct {
+	Runs          int
+	Attacks       int // runs in which the attack succeeded
+	VictimPaid    int
+	MeanBribeCost float64
+	MaxBribeCost  uint64
+}
+
+type requestKind int
+
+const (
+	claim requestKind = iota
+	refund
+)
+
+type request struct {
+	kind requestKind
+}
+
+type validator struct {
+	rational bool
+	fee      uint64
+}
+
+type chain struct {
+	cfg        ChainConfig
+	validators []validator
+	pending    []*request
+	deadline   time.Duration
+	settled    bool
+	include    func(req *request, at time.Duration)
+	censor     func(req *request) bool
+}
+
+type run struct {
+	cfg Config
+	rng *rand.Rand
+	eng Engine
+	a   *chain
+	b   *chain
+	out Outcome
+}
+
+// Simulate runs the attack cfg.Runs times, each run with its own seed derived
+// from cfg.Seed, and returns the outcome of every run
+func Simulate(cfg Config) ([]Outcome, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	outcomes := make([]Outcome, 0, cfg.Runs)
+	for i := 0; i < cfg.Runs; i++ {
+		r := &run{cfg: cfg, rng: rand.New(rand.NewSource(cfg.Seed + int64(i)))}
+		r.out.Run = i
+		outcomes = append(outcomes, r.simulate())
+	}
+	return outcomes, nil
+}
+
+// Summarize aggregates the outcomes of Simulate
+func Summarize(outcomes []Outcome) Summary {
+	s := Summary{Runs: len(outcomes)}
+	total := uint64(0)
+	for _, o := range outcomes {
+		if o.BriberRefunded {
+			s.Attacks++
+		}
+		if o.VictimPaid {
+			s.VictimPaid++
+		}
+		total += o.BribeCost
+		if o.BribeCost > s.MaxBribeCost {
+			s.MaxBribeCost = o.BribeCost
+		}
+	}
+	if s.Runs != 0 {
+		s.MeanBribeCost = float64(total) / float64(s.Runs)
+	}
+	return s
+}
+
+func (cfg *Config) validate() error {
+	for _, c := range []ChainConfig{cfg.ChainA, cfg.ChainB} {
+		switch {
+		case c.BlockInterval <= 0 || c.Jitter < 0 || c.Jitter >= c.BlockInterval:
+			return errors.New("bribery: invalid block interval")
+		case c.Committee <= 0 || c.Rational < 0 || c.Rational > c.Committee:
+			return errors.New("bribery: invalid committee")
+		case c.Quorum <= 0 || c.Quorum > c.Committee:
+			return errors.New("bribery: invalid quorum")
+		}
+	}
+	if cfg.TimelockA <= 0 || cfg.TimelockB <= 0 {
+		return errors.New("bribery: invalid timelock")
+	}
+	if cfg.Runs <= 0 {
+		return errors.New("bribery: invalid number of runs")
+	}
+	return nil
+}
+
+func (r *run) simulate() Outcome {
+	r.a = r.newChain(r.cfg.ChainA, r.cfg.TimelockA)
+	r.b = r.newChain(r.cfg.ChainB, r.cfg.TimelockB)
+	r.a.censor = r.censor
+	r.a.include = func(req *request, at time.Duration) {
+		if req.kind == claim {
+			r.out.ClaimIncluded = at
+			r.out.VictimPaid = true
+			return
+		}
+		r.out.RefundIncluded = at
+		r.out.BriberRefunded = true
+	}
+	r.b.include = func(req *request, at time.Duration) {
+		// the briber's claim on chain B reveals the secret to the victim
+		r.out.SecretRevealed = at
+		r.eng.After(r.cfg.ReactionDelay, func() {
+			r.out.ClaimSubmitted = r.eng.Now()
+			r.a.pending = append(r.a.pending, &request{kind: claim})
+		})
+	}
+
+	r.b.pending = append(r.b.pending, &request{kind: claim})
+	r.eng.At(r.cfg.TimelockA, func() {
+		r.a.pending = append(r.a.pending, &request{kind: refund})
+	})
+	r.schedule(r.a, time.Duration(r.rng.Int63n(int64(r.cfg.ChainA.BlockInterval))))
+	r.schedule(r.b, time.Duration(r.rng.Int63n(int64(r.cfg.ChainB.BlockInterval))))
+	r.eng.Run(r.cfg.TimelockA + 10*r.cfg.ChainA.BlockInterval)
+	return r.out
+}
+
+func (r *run) newChain(cfg ChainConfig, deadline time.Duration) *chain {
+	c := &chain{cfg: cfg, deadline: deadline}
+	for i := 0; i < cfg.Committee; i++ {
+		// fees vary between half and one and a half times the mean
+		fee := cfg.Fee/2 + uint64(r.rng.Int63n(int64(cfg.Fee)+1))
+		c.validators = append(c.validators, validator{rational: i < cfg.Rational, fee: fee})
+	}
+	return c
+}
+
+// schedule produces the next block of the chain after d, with jitter
+func (r *run) schedule(c *chain, d time.Duration) {
+	if c.cfg.Jitter > 0 {
+		d += time.Duration(r.rng.Int63n(int64(2*c.cfg.Jitter)+1)) - c.cfg.Jitter
+	}
+	r.eng.After(d, func() {
+		r.block(c)
+		if !c.settled {
+			r.schedule(c, c.cfg.BlockInterval)
+		}
+	})
+}
+
+// block includes the pending requests that the contract accepts at this time
+// and that a quorum of the committee is willing to include
+func (r *run) block(c *chain) {
+	now := r.eng.Now()
+	var left []*request
+	for _, req := range c.pending {
+		switch {
+		case c.settled:
+		case req.kind == claim && now > c.deadline:
+			// the contract rejects the claim, the swap has expired
+		case req.kind == refund && now <= c.deadline:
+			left = append(left, req)
+		case c.censor != nil && c.censor(req):
+			left = append(left, req)
+		default:
+			c.settled = true
+			c.include(req, now)
+		}
+	}
+	c.pending = left
+}
+
+// censor bribes just enough members of chain A to deny the victim's claim a
+// quorum in the current block, as long as the bribes are accepted and the
+// briber's budget allows for it
+func (r *run) censor(req *request) bool {
+	if req.kind != claim || r.cfg.Bribe == 0 {
+		return false
+	}
+	need := len(r.a.validators) - r.a.cfg.Quorum + 1
+	takers := 0
+	for _, v := range r.a.validators {
+		if v.rational && r.cfg.Bribe > v.fee {
+			takers++
+		}
+	}
+	if takers < need {
+		return false
+	}
+	budget := r.cfg.Budget
+	if budget == 0 {
+		budget = r.cfg.Value
+	}
+	cost := uint64(need) * r.cfg.Bribe
+	if r.out.BribeCost+cost > budget {
+		return false
+	}
+	r.out.BribeCost += cost
+	r.out.BribedBlocks++
+	return true
+}
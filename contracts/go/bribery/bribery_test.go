@@ -0,0 +1,127 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package bribery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func config() Config {
+	chain := ChainConfig{
+		BlockInterval: 10 * time.Second,
+		Jitter:        2 * time.Second,
+		Committee:     4,
+		Rational:      4,
+		Quorum:        3,
+		Fee:           10,
+	}
+	return Config{
+		ChainA:        chain,
+		ChainB:        chain,
+		Value:         10000,
+		TimelockA:     10 * time.Minute,
+		TimelockB:     5 * time.Minute,
+		ReactionDelay: 30 * time.Second,
+		Runs:          20,
+		Seed:          1,
+	}
+}
+
+func TestEngineOrder(t *testing.T) {
+	var e Engine
+	var order []int
+	e.At(2*time.Second, func() { order = append(order, 3) })
+	e.At(time.Second, func() {
+		order = append(order, 1)
+		e.After(0, func() { order = append(order, 2) })
+	})
+	e.At(time.Second, func() { order = append(order, 2) })
+	e.At(time.Minute, func() { order = append(order, 4) })
+	e.Run(10 * time.Second)
+	require.Equal(t, []int{1, 2, 2, 3}, order)
+	require.Equal(t, 2*time.Second, e.Now())
+}
+
+func TestNoBribe(t *testing.T) {
+	outcomes, err := Simulate(config())
+	require.NoError(t, err)
+	for _, o := range outcomes {
+		require.True(t, o.VictimPaid)
+		require.False(t, o.BriberRefunded)
+		require.Zero(t, o.BribeCost)
+		require.Greater(t, o.ClaimIncluded, o.SecretRevealed)
+	}
+}
+
+func TestSuccessfulAttack(t *testing.T) {
+	cfg := config()
+	cfg.Bribe = 20
+	outcomes, err := Simulate(cfg)
+	require.NoError(t, err)
+	for _, o := range outcomes {
+		require.False(t, o.VictimPaid)
+		require.True(t, o.BriberRefunded)
+		require.Greater(t, o.RefundIncluded, cfg.TimelockA)
+		// two of the four members are bribed for every block the claim waits
+		require.EqualValues(t, uint64(o.BribedBlocks)*2*cfg.Bribe, o.BribeCost)
+		require.Less(t, o.BribeCost, cfg.Value)
+	}
+	s := Summarize(outcomes)
+	require.Equal(t, cfg.Runs, s.Attacks)
+	require.Zero(t, s.VictimPaid)
+	require.Greater(t, s.MeanBribeCost, 0.0)
+}
+
+func TestHonestQuorum(t *testing.T) {
+	cfg := config()
+	cfg.Bribe = 20
+	cfg.ChainA.Rational = 1
+	outcomes, err := Simulate(cfg)
+	require.NoError(t, err)
+	for _, o := range outcomes {
+		require.True(t, o.VictimPaid)
+		require.Zero(t, o.BribeCost)
+	}
+}
+
+func TestBribeBelowFee(t *testing.T) {
+	cfg := config()
+	cfg.Bribe = 4
+	outcomes, err := Simulate(cfg)
+	require.NoError(t, err)
+	require.Equal(t, cfg.Runs, Summarize(outcomes).VictimPaid)
+}
+
+func TestBudgetExhausted(t *testing.T) {
+	cfg := config()
+	cfg.Bribe = 20
+	cfg.Budget = 400
+	outcomes, err := Simulate(cfg)
+	require.NoError(t, err)
+	for _, o := range outcomes {
+		require.True(t, o.VictimPaid)
+		require.LessOrEqual(t, o.BribeCost, cfg.Budget)
+		require.Equal(t, 10, o.BribedBlocks)
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	cfg := config()
+	cfg.Bribe = 20
+	first, err := Simulate(cfg)
+	require.NoError(t, err)
+	second, err := Simulate(cfg)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cfg := config()
+	cfg.ChainA.Quorum = 5
+	_, err := Simulate(cfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,74 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package bribery
+
+import (
+	"container/heap"
+	"time"
+)
+
+// Engine is a discrete-event engine, it runs scheduled events in time order
+// and events scheduled for the same time in the order they were scheduled
+type Engine struct {
+	now   time.Duration
+	seq   int
+	queue eventQueue
+}
+
+type event struct {
+	at  time.Duration
+	seq int
+	fn  func()
+}
+
+// Now returns the simulated time since the start of the run
+func (e *Engine) Now() time.Duration {
+	return e.now
+}
+
+// At schedules fn to run at time at, events in the past run immediately after
+// the current one
+func (e *Engine) At(at time.Duration, fn func()) {
+	if at < e.now {
+		at = e.now
+	}
+	e.seq++
+	heap.Push(&e.queue, &event{at: at, seq: e.seq, fn: fn})
+}
+
+// After schedules fn to run d after the current time
+func (e *Engine) After(d time.Duration, fn func()) {
+	e.At(e.now+d, fn)
+}
+
+// Run processes events until none are left or the next one is after until
+func (e *Engine) Run(until time.Duration) {
+	for e.queue.Len() > 0 && e.queue[0].at <= until {
+		ev := heap.Pop(&e.queue).(*event)
+		e.now = ev.at
+		ev.fn()
+	}
+}
+
+type eventQueue []*event
+
+func (q eventQueue) Len() int { return len(q) }
+
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q eventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *eventQueue) Push(x interface{}) { *q = append(*q, x.(*event)) }
+
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	ev := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return ev
+}
@@ -0,0 +1,221 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ptlc creates and completes the Ed25519 adaptor signatures used with
+// point time-locked swaps. A swap is locked with a point T = t·G instead of a
+// hash digest and claimed with the secret scalar t. A pre-signature made for T
+// becomes a valid Ed25519 signature once it is completed with t, and whoever
+// sees both can extract t, so a claim on one chain reveals the secret needed
+// on the other one without both legs sharing a digest.
+package ptlc
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"errors"
+	"io"
+
+	"filippo.io/edwards25519"
+)
+
+const (
+	// SecretSize is the size of an encoded secret scalar
+	SecretSize = 32
+	// PointSize is the size of an encoded lock point
+	PointSize = 32
+	// PreSignatureSize is the size of a pre-signature, R+T followed by s'
+	PreSignatureSize = 64
+)
+
+var (
+	ErrInvalidSecret       = errors.New("ptlc: invalid secret")
+	ErrInvalidPoint        = errors.New("ptlc: invalid point")
+	ErrInvalidKey          = errors.New("ptlc: invalid private key")
+	ErrInvalidPreSignature = errors.New("ptlc: invalid pre-signature")
+	ErrInvalidSignature    = errors.New("ptlc: invalid signature")
+)
+
+// NewSecret draws a random secret scalar from rand and returns it together
+// with the point that locks the swap
+func NewSecret(rand io.Reader) (secret, point []byte, err error) {
+	var buf [64]byte
+	if _, err = io.ReadFull(rand, buf[:]); err != nil {
+		return nil, nil, err
+	}
+	t, err := edwards25519.NewScalar().SetUniformBytes(buf[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return t.Bytes(), new(edwards25519.Point).ScalarBaseMult(t).Bytes(), nil
+}
+
+// PointOf returns the lock point t·G of a secret scalar, this is the check the
+// contract performs when a point-locked swap is claimed
+func PointOf(secret []byte) ([]byte, error) {
+	t, err := scalar(secret)
+	if err != nil {
+		return nil, err
+	}
+	return new(edwards25519.Point).ScalarBaseMult(t).Bytes(), nil
+}
+
+// Offset adds the scalar offset to a secret, OffsetPoint does the same for
+// its point. Locking each leg of a swap with its own offset point keeps the
+// legs unlinkable, while a party that knows the offset can still derive one
+// leg's secret from the other.
+func Offset(secret, offset []byte) ([]byte, error) {
+	t, err := scalar(secret)
+	if err != nil {
+		return nil, err
+	}
+	d, err := scalar(offset)
+	if err != nil {
+		return nil, err
+	}
+	return edwards25519.NewScalar().Add(t, d).Bytes(), nil
+}
+
+// OffsetPoint returns point + offset·G
+func OffsetPoint(point, offset []byte) ([]byte, error) {
+	p, err := decodePoint(point)
+	if err != nil {
+		return nil, err
+	}
+	d, err := scalar(offset)
+	if err != nil {
+		return nil, err
+	}
+	return new(edwards25519.Point).Add(p, new(edwards25519.Point).ScalarBaseMult(d)).Bytes(), nil
+}
+
+// PreSign makes a pre-signature of msg for the lock point. It is not a valid
+// signature by itself, Complete turns it into one given the secret of point.
+func PreSign(priv ed25519.PrivateKey, msg, point []byte) ([]byte, error) {
+	if len(priv) != ed25519.PrivateKeySize {
+		return nil, ErrInvalidKey
+	}
+	T, err := decodePoint(point)
+	if err != nil {
+		return nil, err
+	}
+	h := sha512.Sum512(priv.Seed())
+	x, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	if err != nil {
+		return nil, err
+	}
+	A := priv.Public().(ed25519.PublicKey)
+
+	// deterministic nonce, as in Ed25519 but also bound to the lock point
+	nonce := sha512.New()
+	nonce.Write(h[32:])
+	nonce.Write(point)
+	nonce.Write(msg)
+	r, err := edwards25519.NewScalar().SetUniformBytes(nonce.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	R := new(edwards25519.Point).Add(new(edwards25519.Point).ScalarBaseMult(r), T)
+	c, err := challenge(R.Bytes(), A, msg)
+	if err != nil {
+		return nil, err
+	}
+	s := edwards25519.NewScalar().MultiplyAdd(c, x, r)
+	return append(R.Bytes(), s.Bytes()...), nil
+}
+
+// VerifyPreSignature tells whether preSig is a pre-signature of msg by pub
+// for the lock point, i.e. whether completing it with the secret of point
+// yields a valid signature
+func VerifyPreSignature(pub ed25519.PublicKey, msg, point, preSig []byte) bool {
+	if len(pub) != ed25519.PublicKeySize || len(preSig) != PreSignatureSize {
+		return false
+	}
+	A, err := decodePoint(pub)
+	if err != nil {
+		return false
+	}
+	T, err := decodePoint(point)
+	if err != nil {
+		return false
+	}
+	R, err := decodePoint(preSig[:32])
+	if err != nil {
+		return false
+	}
+	s, err := scalar(preSig[32:])
+	if err != nil {
+		return false
+	}
+	c, err := challenge(preSig[:32], pub, msg)
+	if err != nil {
+		return false
+	}
+	// s'·G == R - T + c·A
+	lhs := new(edwards25519.Point).ScalarBaseMult(s)
+	rhs := new(edwards25519.Point).Subtract(R, T)
+	rhs.Add(rhs, new(edwards25519.Point).ScalarMult(c, A))
+	return lhs.Equal(rhs) == 1
+}
+
+// Complete adds the secret to a pre-signature, the result is a regular
+// Ed25519 signature that verifies with ed25519.Verify
+func Complete(preSig, secret []byte) ([]byte, error) {
+	if len(preSig) != PreSignatureSize {
+		return nil, ErrInvalidPreSignature
+	}
+	s, err := scalar(preSig[32:])
+	if err != nil {
+		return nil, ErrInvalidPreSignature
+	}
+	t, err := scalar(secret)
+	if err != nil {
+		return nil, err
+	}
+	sig := append([]byte{}, preSig[:32]...)
+	return append(sig, edwards25519.NewScalar().Add(s, t).Bytes()...), nil
+}
+
+// Extract recovers the secret from a pre-signature and the signature it was
+// completed into
+func Extract(preSig, sig []byte) ([]byte, error) {
+	if len(preSig) != PreSignatureSize {
+		return nil, ErrInvalidPreSignature
+	}
+	if len(sig) != ed25519.SignatureSize || string(sig[:32]) != string(preSig[:32]) {
+		return nil, ErrInvalidSignature
+	}
+	s1, err := scalar(preSig[32:])
+	if err != nil {
+		return nil, ErrInvalidPreSignature
+	}
+	s2, err := scalar(sig[32:])
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	return edwards25519.NewScalar().Subtract(s2, s1).Bytes(), nil
+}
+
+// challenge computes the Ed25519 challenge H(R || A || msg)
+func challenge(R, A, msg []byte) (*edwards25519.Scalar, error) {
+	h := sha512.New()
+	h.Write(R)
+	h.Write(A)
+	h.Write(msg)
+	return edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+}
+
+func scalar(b []byte) (*edwards25519.Scalar, error) {
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(b)
+	if err != nil {
+		return nil, ErrInvalidSecret
+	}
+	return s, nil
+}
+
+func decodePoint(b []byte) (*edwards25519.Point, error) {
+	p, err := new(edwards25519.Point).SetBytes(b)
+	if err != nil {
+		return nil, ErrInvalidPoint
+	}
+	return p, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package ptlc
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var msg = []byte("claim htlc swap")
+
+func TestAdaptorSignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	secret, point, err := NewSecret(rand.Reader)
+	require.NoError(t, err)
+
+	preSig, err := PreSign(priv, msg, point)
+	require.NoError(t, err)
+	require.True(t, VerifyPreSignature(pub, msg, point, preSig))
+	require.False(t, ed25519.Verify(pub, msg, preSig))
+
+	sig, err := Complete(preSig, secret)
+	require.NoError(t, err)
+	require.True(t, ed25519.Verify(pub, msg, sig))
+
+	extracted, err := Extract(preSig, sig)
+	require.NoError(t, err)
+	require.Equal(t, secret, extracted)
+}
+
+func TestVerifyPreSignatureRejects(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	other, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, point, err := NewSecret(rand.Reader)
+	require.NoError(t, err)
+	_, otherPoint, err := NewSecret(rand.Reader)
+	require.NoError(t, err)
+
+	preSig, err := PreSign(priv, msg, point)
+	require.NoError(t, err)
+	require.False(t, VerifyPreSignature(other, msg, point, preSig))
+	require.False(t, VerifyPreSignature(pub, []byte("other"), point, preSig))
+	require.False(t, VerifyPreSignature(pub, msg, otherPoint, preSig))
+
+	// completing with the wrong secret does not give a valid signature
+	wrong, _, err := NewSecret(rand.Reader)
+	require.NoError(t, err)
+	sig, err := Complete(preSig, wrong)
+	require.NoError(t, err)
+	require.False(t, ed25519.Verify(pub, msg, sig))
+}
+
+func TestPointOf(t *testing.T) {
+	secret, point, err := NewSecret(rand.Reader)
+	require.NoError(t, err)
+	p, err := PointOf(secret)
+	require.NoError(t, err)
+	require.Equal(t, point, p)
+
+	_, err = PointOf(make([]byte, 31))
+	require.ErrorIs(t, err, ErrInvalidSecret)
+}
+
+func TestOffset(t *testing.T) {
+	secret, point, err := NewSecret(rand.Reader)
+	require.NoError(t, err)
+	offset, _, err := NewSecret(rand.Reader)
+	require.NoError(t, err)
+
+	secret2, err := Offset(secret, offset)
+	require.NoError(t, err)
+	point2, err := OffsetPoint(point, offset)
+	require.NoError(t, err)
+	require.NotEqual(t, point, point2)
+
+	p, err := PointOf(secret2)
+	require.NoError(t, err)
+	require.Equal(t, point2, p)
+}
@@ -16,50 +16,115 @@ const (
 )
 
 const (
-	ParamKey       = "key"
-	ParamOwner     = "owner"
-	ParamReceivder = "receivder"
-	ParamSecret    = "secret"
-	ParamTime      = "time"
-	ParamValue     = "value"
+	ParamAgentID      = "agentID"
+	ParamBribeDelay   = "bribeDelay"
+	ParamCollateral   = "collateral"
+	ParamHashAlgo     = "hashAlgo"
+	ParamHashlock     = "hashlock"
+	ParamOwner        = "owner"
+	ParamPreimage     = "preimage"
+	ParamPremium      = "premium"
+	ParamPubKey       = "pubKey"
+	ParamReceivder    = "receivder"
+	ParamRecipient    = "recipient"
+	ParamRefundSecret = "refundSecret"
+	ParamRefundlock   = "refundlock"
+	ParamRelayerFee   = "relayerFee"
+	ParamRole         = "role"
+	ParamSignature    = "signature"
+	ParamSwapID       = "swapID"
+	ParamTime         = "time"
 )
 
 const (
-	ResultOwner = "owner"
-	ResultValue = "value"
+	ResultAdmin        = "admin"
+	ResultFee          = "fee"
+	ResultNfts         = "nfts"
+	ResultOperator     = "operator"
+	ResultOwner        = "owner"
+	ResultPaused       = "paused"
+	ResultPauser       = "pauser"
+	ResultPendingOwner = "pendingOwner"
+	ResultPreimage     = "preimage"
+	ResultPremium      = "premium"
+	ResultRecipient    = "recipient"
+	ResultStatus       = "status"
+	ResultSwap         = "swap"
+	ResultSwapID       = "swapID"
+	ResultTokens       = "tokens"
+	ResultValue        = "value"
 )
 
 const (
-	StateInitTime  = "initTime"
-	StateOwner     = "owner"
-	StateReceivder = "receivder"
-	StateSecret    = "secret"
-	StateTime      = "time"
-	StateValue     = "value"
+	StateMadSwaps     = "madSwaps"
+	StateOperators    = "operators"
+	StateOwner        = "owner"
+	StatePaused       = "paused"
+	StatePausers      = "pausers"
+	StatePendingOwner = "pendingOwner"
+	StateSwapNfts     = "swapNfts"
+	StateSwapTokens   = "swapTokens"
+	StateSwaps        = "swaps"
 )
 
 const (
-	FuncInit         = "init"
-	FuncSetOwner     = "setOwner"
-	FuncSetReceivder = "setReceivder"
-	FuncSetSecret    = "setSecret"
-	FuncSetTime      = "setTime"
-	FuncSetValue     = "setValue"
-	FuncTransfer     = "transfer"
-	FuncWithdraw     = "withdraw"
-	ViewGetOwner     = "getOwner"
-	ViewGetValue     = "getValue"
+	FuncAcceptOwnership      = "acceptOwnership"
+	FuncApproveExtension     = "approveExtension"
+	FuncCancel               = "cancel"
+	FuncCancelOwnership      = "cancelOwnership"
+	FuncClaim                = "claim"
+	FuncExtendTimelock       = "extendTimelock"
+	FuncGrantRole            = "grantRole"
+	FuncInit                 = "init"
+	FuncMadClaim             = "madClaim"
+	FuncMadCollateral        = "madCollateral"
+	FuncMadNewSwap           = "madNewSwap"
+	FuncMadRefund            = "madRefund"
+	FuncMadReleaseCollateral = "madReleaseCollateral"
+	FuncMadSweep             = "madSweep"
+	FuncNewSwap              = "newSwap"
+	FuncPause                = "pause"
+	FuncPostCollateral       = "postCollateral"
+	FuncProposeOwner         = "proposeOwner"
+	FuncRefund               = "refund"
+	FuncRevokeRole           = "revokeRole"
+	FuncUnpause              = "unpause"
+	ViewGetMadSwap           = "getMadSwap"
+	ViewGetOwner             = "getOwner"
+	ViewGetPreimage          = "getPreimage"
+	ViewGetRoles             = "getRoles"
+	ViewGetStatus            = "getStatus"
+	ViewGetSwap              = "getSwap"
+	ViewIsPaused             = "isPaused"
 )
 
 const (
-	HFuncInit         = wasmtypes.ScHname(0x1f44d644)
-	HFuncSetOwner     = wasmtypes.ScHname(0x2a15fe7b)
-	HFuncSetReceivder = wasmtypes.ScHname(0x5fd98b09)
-	HFuncSetSecret    = wasmtypes.ScHname(0x7ebcfec8)
-	HFuncSetTime      = wasmtypes.ScHname(0xba1b35f9)
-	HFuncSetValue     = wasmtypes.ScHname(0xce30e109)
-	HFuncTransfer     = wasmtypes.ScHname(0xa15da184)
-	HFuncWithdraw     = wasmtypes.ScHname(0x9dcc0f41)
-	HViewGetOwner     = wasmtypes.ScHname(0x137107a6)
-	HViewGetValue     = wasmtypes.ScHname(0x040813fd)
+	HFuncAcceptOwnership      = wasmtypes.ScHname(0xc17dc4ac)
+	HFuncApproveExtension     = wasmtypes.ScHname(0x6d91db5d)
+	HFuncCancel               = wasmtypes.ScHname(0xa7e99697)
+	HFuncCancelOwnership      = wasmtypes.ScHname(0x007bf832)
+	HFuncClaim                = wasmtypes.ScHname(0x3f8088b3)
+	HFuncExtendTimelock       = wasmtypes.ScHname(0x2f8b383e)
+	HFuncGrantRole            = wasmtypes.ScHname(0x8f3a4390)
+	HFuncInit                 = wasmtypes.ScHname(0x1f44d644)
+	HFuncMadClaim             = wasmtypes.ScHname(0x31116fd2)
+	HFuncMadCollateral        = wasmtypes.ScHname(0xeb652099)
+	HFuncMadNewSwap           = wasmtypes.ScHname(0x5d1052b1)
+	HFuncMadRefund            = wasmtypes.ScHname(0x3aaed1c8)
+	HFuncMadReleaseCollateral = wasmtypes.ScHname(0xfdfd1f63)
+	HFuncMadSweep             = wasmtypes.ScHname(0x1c064a1d)
+	HFuncNewSwap              = wasmtypes.ScHname(0x476bfbda)
+	HFuncPause                = wasmtypes.ScHname(0x04c6e081)
+	HFuncPostCollateral       = wasmtypes.ScHname(0x3a27cf6a)
+	HFuncProposeOwner         = wasmtypes.ScHname(0x1390be1b)
+	HFuncRefund               = wasmtypes.ScHname(0x4174a4a5)
+	HFuncRevokeRole           = wasmtypes.ScHname(0x2ed69e71)
+	HFuncUnpause              = wasmtypes.ScHname(0x49666167)
+	HViewGetMadSwap           = wasmtypes.ScHname(0x2a5812bc)
+	HViewGetOwner             = wasmtypes.ScHname(0x137107a6)
+	HViewGetPreimage          = wasmtypes.ScHname(0x601f46b3)
+	HViewGetRoles             = wasmtypes.ScHname(0x8855ea4c)
+	HViewGetStatus            = wasmtypes.ScHname(0xc76eb352)
+	HViewGetSwap              = wasmtypes.ScHname(0xff7f1e00)
+	HViewIsPaused             = wasmtypes.ScHname(0x14b2cd5a)
 )
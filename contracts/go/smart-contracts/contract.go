@@ -9,43 +9,116 @@ package htlc
 
 import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib"
 
+type AcceptOwnershipCall struct {
+	Func    *wasmlib.ScFunc
+}
+
+type ApproveExtensionCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableApproveExtensionParams
+}
+
+type CancelCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableCancelParams
+}
+
+type CancelOwnershipCall struct {
+	Func    *wasmlib.ScFunc
+}
+
+type ClaimCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableClaimParams
+	Results ImmutableClaimResults
+}
+
+type ExtendTimelockCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableExtendTimelockParams
+}
+
+type GrantRoleCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableGrantRoleParams
+}
+
 type InitCall struct {
 	Func    *wasmlib.ScInitFunc
 	Params  MutableInitParams
 }
 
-type SetOwnerCall struct {
+type MadClaimCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableMadClaimParams
+}
+
+type MadCollateralCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableMadCollateralParams
+}
+
+type MadNewSwapCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableMadNewSwapParams
+	Results ImmutableMadNewSwapResults
+}
+
+type MadRefundCall struct {
 	Func    *wasmlib.ScFunc
-	Params  MutableSetOwnerParams
+	Params  MutableMadRefundParams
 }
 
-type SetReceivderCall struct {
+type MadReleaseCollateralCall struct {
 	Func    *wasmlib.ScFunc
-	Params  MutableSetReceivderParams
+	Params  MutableMadReleaseCollateralParams
 }
 
-type SetSecretCall struct {
+type MadSweepCall struct {
 	Func    *wasmlib.ScFunc
-	Params  MutableSetSecretParams
+	Params  MutableMadSweepParams
+	Results ImmutableMadSweepResults
 }
 
-type SetTimeCall struct {
+type NewSwapCall struct {
 	Func    *wasmlib.ScFunc
-	Params  MutableSetTimeParams
+	Params  MutableNewSwapParams
+	Results ImmutableNewSwapResults
 }
 
-type SetValueCall struct {
+type PauseCall struct {
 	Func    *wasmlib.ScFunc
-	Params  MutableSetValueParams
 }
 
-type TransferCall struct {
+type PostCollateralCall struct {
 	Func    *wasmlib.ScFunc
-	Params  MutableTransferParams
+	Params  MutablePostCollateralParams
 }
 
-type WithdrawCall struct {
+type ProposeOwnerCall struct {
 	Func    *wasmlib.ScFunc
+	Params  MutableProposeOwnerParams
+}
+
+type RefundCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableRefundParams
+	Results ImmutableRefundResults
+}
+
+type RevokeRoleCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableRevokeRoleParams
+}
+
+type UnpauseCall struct {
+	Func    *wasmlib.ScFunc
+}
+
+type GetMadSwapCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetMadSwapParams
+	Results ImmutableGetMadSwapResults
 }
 
 type GetOwnerCall struct {
@@ -53,59 +126,167 @@ type GetOwnerCall struct {
 	Results ImmutableGetOwnerResults
 }
 
-type GetValueCall struct {
+type GetPreimageCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetPreimageParams
+	Results ImmutableGetPreimageResults
+}
+
+type GetRolesCall struct {
 	Func    *wasmlib.ScView
-	Results ImmutableGetValueResults
+	Params  MutableGetRolesParams
+	Results ImmutableGetRolesResults
+}
+
+type GetStatusCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetStatusParams
+	Results ImmutableGetStatusResults
+}
+
+type GetSwapCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetSwapParams
+	Results ImmutableGetSwapResults
+}
+
+type IsPausedCall struct {
+	Func    *wasmlib.ScView
+	Results ImmutableIsPausedResults
 }
 
 type Funcs struct{}
 
 var ScFuncs Funcs
 
+func (sc Funcs) AcceptOwnership(ctx wasmlib.ScFuncCallContext) *AcceptOwnershipCall {
+	return &AcceptOwnershipCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncAcceptOwnership)}
+}
+
+func (sc Funcs) ApproveExtension(ctx wasmlib.ScFuncCallContext) *ApproveExtensionCall {
+	f := &ApproveExtensionCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncApproveExtension)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	return f
+}
+
+func (sc Funcs) Cancel(ctx wasmlib.ScFuncCallContext) *CancelCall {
+	f := &CancelCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncCancel)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	return f
+}
+
+func (sc Funcs) CancelOwnership(ctx wasmlib.ScFuncCallContext) *CancelOwnershipCall {
+	return &CancelOwnershipCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncCancelOwnership)}
+}
+
+func (sc Funcs) Claim(ctx wasmlib.ScFuncCallContext) *ClaimCall {
+	f := &ClaimCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncClaim)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	wasmlib.NewCallResultsProxy(&f.Func.ScView, &f.Results.proxy)
+	return f
+}
+
+func (sc Funcs) ExtendTimelock(ctx wasmlib.ScFuncCallContext) *ExtendTimelockCall {
+	f := &ExtendTimelockCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncExtendTimelock)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	return f
+}
+
+func (sc Funcs) GrantRole(ctx wasmlib.ScFuncCallContext) *GrantRoleCall {
+	f := &GrantRoleCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncGrantRole)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	return f
+}
+
 func (sc Funcs) Init(ctx wasmlib.ScFuncCallContext) *InitCall {
 	f := &InitCall{Func: wasmlib.NewScInitFunc(ctx, HScName, HFuncInit)}
 	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
 	return f
 }
 
-func (sc Funcs) SetOwner(ctx wasmlib.ScFuncCallContext) *SetOwnerCall {
-	f := &SetOwnerCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncSetOwner)}
+func (sc Funcs) MadClaim(ctx wasmlib.ScFuncCallContext) *MadClaimCall {
+	f := &MadClaimCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncMadClaim)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	return f
+}
+
+func (sc Funcs) MadCollateral(ctx wasmlib.ScFuncCallContext) *MadCollateralCall {
+	f := &MadCollateralCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncMadCollateral)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	return f
+}
+
+func (sc Funcs) MadNewSwap(ctx wasmlib.ScFuncCallContext) *MadNewSwapCall {
+	f := &MadNewSwapCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncMadNewSwap)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	wasmlib.NewCallResultsProxy(&f.Func.ScView, &f.Results.proxy)
+	return f
+}
+
+func (sc Funcs) MadRefund(ctx wasmlib.ScFuncCallContext) *MadRefundCall {
+	f := &MadRefundCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncMadRefund)}
 	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
 	return f
 }
 
-func (sc Funcs) SetReceivder(ctx wasmlib.ScFuncCallContext) *SetReceivderCall {
-	f := &SetReceivderCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncSetReceivder)}
+func (sc Funcs) MadReleaseCollateral(ctx wasmlib.ScFuncCallContext) *MadReleaseCollateralCall {
+	f := &MadReleaseCollateralCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncMadReleaseCollateral)}
 	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
 	return f
 }
 
-func (sc Funcs) SetSecret(ctx wasmlib.ScFuncCallContext) *SetSecretCall {
-	f := &SetSecretCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncSetSecret)}
+func (sc Funcs) MadSweep(ctx wasmlib.ScFuncCallContext) *MadSweepCall {
+	f := &MadSweepCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncMadSweep)}
 	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	wasmlib.NewCallResultsProxy(&f.Func.ScView, &f.Results.proxy)
 	return f
 }
 
-func (sc Funcs) SetTime(ctx wasmlib.ScFuncCallContext) *SetTimeCall {
-	f := &SetTimeCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncSetTime)}
+func (sc Funcs) NewSwap(ctx wasmlib.ScFuncCallContext) *NewSwapCall {
+	f := &NewSwapCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncNewSwap)}
 	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	wasmlib.NewCallResultsProxy(&f.Func.ScView, &f.Results.proxy)
 	return f
 }
 
-func (sc Funcs) SetValue(ctx wasmlib.ScFuncCallContext) *SetValueCall {
-	f := &SetValueCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncSetValue)}
+func (sc Funcs) Pause(ctx wasmlib.ScFuncCallContext) *PauseCall {
+	return &PauseCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncPause)}
+}
+
+func (sc Funcs) PostCollateral(ctx wasmlib.ScFuncCallContext) *PostCollateralCall {
+	f := &PostCollateralCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncPostCollateral)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	return f
+}
+
+func (sc Funcs) ProposeOwner(ctx wasmlib.ScFuncCallContext) *ProposeOwnerCall {
+	f := &ProposeOwnerCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncProposeOwner)}
 	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
 	return f
 }
 
-func (sc Funcs) Transfer(ctx wasmlib.ScFuncCallContext) *TransferCall {
-	f := &TransferCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncTransfer)}
+func (sc Funcs) Refund(ctx wasmlib.ScFuncCallContext) *RefundCall {
+	f := &RefundCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncRefund)}
 	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	wasmlib.NewCallResultsProxy(&f.Func.ScView, &f.Results.proxy)
 	return f
 }
 
-func (sc Funcs) Withdraw(ctx wasmlib.ScFuncCallContext) *WithdrawCall {
-	return &WithdrawCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncWithdraw)}
+func (sc Funcs) RevokeRole(ctx wasmlib.ScFuncCallContext) *RevokeRoleCall {
+	f := &RevokeRoleCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncRevokeRole)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(&f.Func.ScView)
+	return f
+}
+
+func (sc Funcs) Unpause(ctx wasmlib.ScFuncCallContext) *UnpauseCall {
+	return &UnpauseCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncUnpause)}
+}
+
+func (sc Funcs) GetMadSwap(ctx wasmlib.ScViewCallContext) *GetMadSwapCall {
+	f := &GetMadSwapCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetMadSwap)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(f.Func)
+	wasmlib.NewCallResultsProxy(f.Func, &f.Results.proxy)
+	return f
 }
 
 func (sc Funcs) GetOwner(ctx wasmlib.ScViewCallContext) *GetOwnerCall {
@@ -114,8 +295,36 @@ func (sc Funcs) GetOwner(ctx wasmlib.ScViewCallContext) *GetOwnerCall {
 	return f
 }
 
-func (sc Funcs) GetValue(ctx wasmlib.ScViewCallContext) *GetValueCall {
-	f := &GetValueCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetValue)}
+func (sc Funcs) GetPreimage(ctx wasmlib.ScViewCallContext) *GetPreimageCall {
+	f := &GetPreimageCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetPreimage)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(f.Func)
+	wasmlib.NewCallResultsProxy(f.Func, &f.Results.proxy)
+	return f
+}
+
+func (sc Funcs) GetRoles(ctx wasmlib.ScViewCallContext) *GetRolesCall {
+	f := &GetRolesCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetRoles)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(f.Func)
+	wasmlib.NewCallResultsProxy(f.Func, &f.Results.proxy)
+	return f
+}
+
+func (sc Funcs) GetStatus(ctx wasmlib.ScViewCallContext) *GetStatusCall {
+	f := &GetStatusCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetStatus)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(f.Func)
+	wasmlib.NewCallResultsProxy(f.Func, &f.Results.proxy)
+	return f
+}
+
+func (sc Funcs) GetSwap(ctx wasmlib.ScViewCallContext) *GetSwapCall {
+	f := &GetSwapCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetSwap)}
+	f.Params.proxy = wasmlib.NewCallParamsProxy(f.Func)
+	wasmlib.NewCallResultsProxy(f.Func, &f.Results.proxy)
+	return f
+}
+
+func (sc Funcs) IsPaused(ctx wasmlib.ScViewCallContext) *IsPausedCall {
+	f := &IsPausedCall{Func: wasmlib.NewScView(ctx, HScName, HViewIsPaused)}
 	wasmlib.NewCallResultsProxy(f.Func, &f.Results.proxy)
 	return f
 }
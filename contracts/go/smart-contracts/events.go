@@ -0,0 +1,144 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// (Re-)generated by schema tool
+// >>>> DO NOT CHANGE THIS FILE! <<<<
+// Change the json schema instead
+
+//nolint:gocritic
+
+package htlc
+
+import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib"
+import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
+
+type htlcEvents struct{}
+
+func (e htlcEvents) CollateralPosted(swapID wasmtypes.ScHash, amount uint64, collateral uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.collateralPosted")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.Uint64ToString(amount))
+	evt.Encode(wasmtypes.Uint64ToString(collateral))
+	evt.Emit()
+}
+
+func (e htlcEvents) CollateralSettled(swapID wasmtypes.ScHash, recipient wasmtypes.ScAgentID, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.collateralSettled")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.AgentIDToString(recipient))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
+
+func (e htlcEvents) MadCollateralPosted(swapID wasmtypes.ScHash, amount uint64, collateral uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.madCollateralPosted")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.Uint64ToString(amount))
+	evt.Encode(wasmtypes.Uint64ToString(collateral))
+	evt.Emit()
+}
+
+func (e htlcEvents) MadCollateralReleased(swapID wasmtypes.ScHash, collateral uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.madCollateralReleased")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.Uint64ToString(collateral))
+	evt.Emit()
+}
+
+func (e htlcEvents) MadSwapClaimed(swapID wasmtypes.ScHash, preimage []byte, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.madSwapClaimed")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.BytesToString(preimage))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
+
+func (e htlcEvents) MadSwapCreated(swapID wasmtypes.ScHash, sender wasmtypes.ScAgentID, receivder wasmtypes.ScAgentID, hashlock wasmtypes.ScHash, refundlock wasmtypes.ScHash, deadline int64) {
+	evt := wasmlib.NewEventEncoder("htlc.madSwapCreated")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.AgentIDToString(sender))
+	evt.Encode(wasmtypes.AgentIDToString(receivder))
+	evt.Encode(wasmtypes.HashToString(hashlock))
+	evt.Encode(wasmtypes.HashToString(refundlock))
+	evt.Encode(wasmtypes.Int64ToString(deadline))
+	evt.Emit()
+}
+
+func (e htlcEvents) MadSwapRefunded(swapID wasmtypes.ScHash, refundSecret []byte, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.madSwapRefunded")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.BytesToString(refundSecret))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
+
+func (e htlcEvents) MadSwapSwept(swapID wasmtypes.ScHash, sweeper wasmtypes.ScAgentID, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.madSwapSwept")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.AgentIDToString(sweeper))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
+
+func (e htlcEvents) PremiumPaid(swapID wasmtypes.ScHash, recipient wasmtypes.ScAgentID, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.premiumPaid")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.AgentIDToString(recipient))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
+
+func (e htlcEvents) SwapCancelRequested(swapID wasmtypes.ScHash, party wasmtypes.ScAgentID) {
+	evt := wasmlib.NewEventEncoder("htlc.swapCancelRequested")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.AgentIDToString(party))
+	evt.Emit()
+}
+
+func (e htlcEvents) SwapCancelled(swapID wasmtypes.ScHash, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.swapCancelled")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
+
+func (e htlcEvents) SwapClaimed(swapID wasmtypes.ScHash, preimage []byte, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.swapClaimed")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.BytesToString(preimage))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
+
+func (e htlcEvents) SwapCreated(swapID wasmtypes.ScHash, sender wasmtypes.ScAgentID, receivder wasmtypes.ScAgentID, hashlock wasmtypes.ScHash, hashAlgo uint8, deadline int64) {
+	evt := wasmlib.NewEventEncoder("htlc.swapCreated")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.AgentIDToString(sender))
+	evt.Encode(wasmtypes.AgentIDToString(receivder))
+	evt.Encode(wasmtypes.HashToString(hashlock))
+	evt.Encode(wasmtypes.Uint8ToString(hashAlgo))
+	evt.Encode(wasmtypes.Int64ToString(deadline))
+	evt.Emit()
+}
+
+func (e htlcEvents) SwapExtended(swapID wasmtypes.ScHash, deadline int64) {
+	evt := wasmlib.NewEventEncoder("htlc.swapExtended")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.Int64ToString(deadline))
+	evt.Emit()
+}
+
+func (e htlcEvents) SwapFunded(swapID wasmtypes.ScHash, amount uint64, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.swapFunded")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.Uint64ToString(amount))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
+
+func (e htlcEvents) SwapRefunded(swapID wasmtypes.ScHash, value uint64) {
+	evt := wasmlib.NewEventEncoder("htlc.swapRefunded")
+	evt.Encode(wasmtypes.HashToString(swapID))
+	evt.Encode(wasmtypes.Uint64ToString(value))
+	evt.Emit()
+}
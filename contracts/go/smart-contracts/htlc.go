@@ -3,62 +3,581 @@
 
 package htlc
 
-import "time"
+import "crypto/sha256"
+import "math"
+import "filippo.io/edwards25519"
 import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib"
+import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/coreaccounts"
 import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
+import "golang.org/x/crypto/sha3"
 
-func funcInit(ctx wasmlib.ScFuncContext, f *InitContext) {
-    if f.Params.Owner().Exists() {
-        f.State.Owner().SetValue(f.Params.Owner().Value())
+// swap status, a swap is funded on creation and settles exactly once by
+// leaving StatusFunded. StatusSwept is only reached by MAD-HTLC swaps.
+const (
+    StatusOpen uint8 = iota
+    StatusFunded
+    StatusClaimed
+    StatusRefunded
+    StatusCancelled
+    StatusSwept
+)
+
+var statusNames = []string{"open", "funded", "claimed", "refunded", "cancelled", "swept"}
+
+// hash algorithms a hashlock can be computed with, SHA-256 pairs with
+// Bitcoin-style locks and Keccak-256 with the EVM HTLC in HTCL.sol. With
+// HashEd25519Point the swap is a PTLC: the hashlock holds an Ed25519 point T
+// and the preimage is the scalar t with T = t·G, see the ptlc package.
+const (
+    HashBlake2b uint8 = iota
+    HashSha256
+    HashKeccak256
+    HashEd25519Point
+)
+
+// roles the admin can grant, any number of agents can be members of a role.
+// The admin role itself is held by the owner of the contract.
+const (
+    RoleOperator = "operator"
+    RolePauser   = "pauser"
+)
+
+// parties that agree to a mutual cancellation, a swap is cancelled as soon
+// as its CancelBy holds both flags
+const (
+    CancelSender uint8 = 1 << iota
+    CancelReceiver
+)
+
+// error codes that claim and refund fail the request with, clients can match
+// on them to tell why a settlement was rejected
+const (
+    ErrWrongPreimage      = "htlc: wrong preimage"
+    ErrWrongRefundSecret  = "htlc: wrong refund secret"
+    ErrExpired            = "htlc: swap expired"
+    ErrNotExpired         = "htlc: swap not yet expired"
+    ErrAlreadySettled     = "htlc: swap already settled"
+    ErrInsufficientEscrow = "htlc: insufficient escrow"
+    ErrPaused             = "htlc: contract paused"
+)
+
+// timestamp returns the deterministic request timestamp in seconds, so that
+// every committee node agrees on whether the timelock has expired
+func timestamp(ctx wasmlib.ScFuncContext) int64 {
+    return int64(ctx.Timestamp() / 1_000_000_000)
+}
+
+// swapID derives the key of a swap from its hashlock and both parties, so the
+// same hashlock can be used with different counterparties
+func swapID(ctx wasmlib.ScFuncContext, hashlock wasmtypes.ScHash, sender wasmtypes.ScAgentID, receivder wasmtypes.ScAgentID) wasmtypes.ScHash {
+    buf := append(hashlock.Bytes(), sender.Bytes()...)
+    buf = append(buf, receivder.Bytes()...)
+    return ctx.Utility().HashBlake2b(buf)
+}
+
+// digest hashes the preimage with the algorithm selected for the swap
+func digest(ctx wasmlib.ScFuncContext, hashAlgo uint8, preimage []byte) wasmtypes.ScHash {
+    switch hashAlgo {
+    case HashSha256:
+        sum := sha256.Sum256(preimage)
+        return wasmtypes.HashFromBytes(sum[:])
+    case HashKeccak256:
+        h := sha3.NewLegacyKeccak256()
+        h.Write(preimage)
+        return wasmtypes.HashFromBytes(h.Sum(nil))
+    case HashEd25519Point:
+        t, err := edwards25519.NewScalar().SetCanonicalBytes(preimage)
+        if err != nil {
+            ctx.Panic(ErrWrongPreimage)
+        }
+        return wasmtypes.HashFromBytes(new(edwards25519.Point).ScalarBaseMult(t).Bytes())
+    }
+    return ctx.Utility().HashBlake2b(preimage)
+}
+
+// existingSwap returns the swap addressed by id, failing the request when
+// there is no such swap
+func existingSwap(ctx wasmlib.ScFuncContext, state MutablehtlcState, id wasmtypes.ScHash) MutableSwap {
+    swap := state.Swaps().GetSwap(id)
+    ctx.Require(swap.Exists(), "unknown swap")
+    return swap
+}
+
+// requireStatus fails the request unless the swap is in one of the allowed
+// states, so that every function only performs valid transitions
+func requireStatus(ctx wasmlib.ScFuncContext, status uint8, allowed ...uint8) {
+    for _, s := range allowed {
+        if status == s {
+            return
+        }
+    }
+    if status >= StatusClaimed {
+        // the bare error code, so clients can match it exactly
+        ctx.Panic(ErrAlreadySettled)
+    }
+    ctx.Panic("swap is " + statusNames[status])
+}
+
+// expired tells whether the timelock of the swap has passed
+func expired(ctx wasmlib.ScFuncContext, swap *Swap) bool {
+    return timestamp(ctx) > swap.InitTime + swap.Time
+}
+
+// validTime tells whether a timelock of t seconds from initTime is positive
+// and its deadline fits in an Int64, so the deadline cannot wrap around
+func validTime(initTime, t int64) bool {
+    return t > 0 && t <= math.MaxInt64 - initTime
+}
+
+// payout sends the transfer to an agent, an L1 address receives it on the
+// ledger while contracts and EVM accounts are credited on this chain
+func payout(ctx wasmlib.ScFuncContext, agent wasmtypes.ScAgentID, transfer *wasmlib.ScTransfer) {
+    if agent.IsAddress() {
+        ctx.Send(agent.Address(), transfer)
         return
     }
-    f.State.Owner().SetValue(ctx.ContractCreator())
-    f.State.Value().SetValue(0)
-    f.State.InitTime().SetValue(time.Now().Unix())
+    f := coreaccounts.ScFuncs.TransferAllowanceTo(ctx)
+    f.Params.AgentID().SetValue(agent)
+    f.Params.ForceOpenAccount().SetValue(true)
+    f.Func.Allowance(transfer).Call()
 }
 
-func funcSetOwner(ctx wasmlib.ScFuncContext, f *SetOwnerContext) {
-	f.State.Owner().SetValue(f.Params.Owner().Value())
+// lockEscrow moves everything allowed by the request into the contract and
+// records the native tokens and NFTs among it for the swap
+func lockEscrow(ctx wasmlib.ScFuncContext, state MutablehtlcState, id wasmtypes.ScHash) {
+    allowance := ctx.Allowance()
+    tokens := state.SwapTokens().GetTokenAmounts(id)
+    for _, tokenID := range allowance.TokenIDs() {
+        tokens.AppendTokenAmount().SetValue(&TokenAmount{TokenID: *tokenID, Amount: allowance.Balance(tokenID)})
+    }
+    nfts := state.SwapNfts().GetNftIDs(id)
+    for _, nftID := range allowance.NftIDs() {
+        nfts.AppendNftID().SetValue(*nftID)
+    }
+    ctx.TransferAllowed(ctx.AccountID(), wasmlib.NewScTransferFromBalances(allowance), false)
 }
 
-func funcSetReceivder(ctx wasmlib.ScFuncContext, f *SetReceivderContext) {
-    f.State.Receivder().SetValue(f.Params.Receivder().Value())
+// releaseEscrow collects everything locked in the swap into a single transfer,
+// making sure the contract actually holds it before anything is paid out. The
+// fee iotas are left out of the transfer, the caller pays them separately.
+func releaseEscrow(ctx wasmlib.ScFuncContext, state MutablehtlcState, id wasmtypes.ScHash, swap *Swap, fee uint64) *wasmlib.ScTransfer {
+    balances := ctx.Balances()
+    ctx.Require(swap.Value > fee && swap.Value <= balances.Iotas(), ErrInsufficientEscrow)
+    transfer := wasmlib.NewScTransferIotas(swap.Value - fee)
+    tokens := state.SwapTokens().GetTokenAmounts(id)
+    for i := uint32(0); i < tokens.Length(); i++ {
+        token := tokens.GetTokenAmount(i).Value()
+        ctx.Require(token.Amount.Cmp(balances.Balance(&token.TokenID)) <= 0, ErrInsufficientEscrow)
+        transfer.Set(&token.TokenID, token.Amount)
+    }
+    tokens.Clear()
+    nfts := state.SwapNfts().GetNftIDs(id)
+    for i := uint32(0); i < nfts.Length(); i++ {
+        nftID := nfts.GetNftID(i).Value()
+        transfer.AddNFT(&nftID)
+    }
+    nfts.Clear()
+    return transfer
 }
 
-func funcSetSecret(ctx wasmlib.ScFuncContext, f *SetSecretContext) {
-    f.State.Secret().SetValue(f.Params.Secret().Value())
+// settleCollateral pays the collateral posted by the receiver back to it, or
+// to the sender when the receiver has forfeited it
+func settleCollateral(ctx wasmlib.ScFuncContext, events htlcEvents, id wasmtypes.ScHash, swap *Swap, forfeit bool) {
+    if swap.CollateralPosted == 0 {
+        return
+    }
+    recipient := swap.Receivder
+    if forfeit {
+        recipient = swap.Sender
+    }
+    ctx.Require(swap.CollateralPosted <= ctx.Balances().Iotas(), ErrInsufficientEscrow)
+    payout(ctx, recipient, wasmlib.NewScTransferIotas(swap.CollateralPosted))
+    events.CollateralSettled(id, recipient, swap.CollateralPosted)
+    swap.CollateralPosted = 0
+}
+
+// payPremium pays the premium of the swap to the recipient, which is the
+// sender when the swap completes and the receiver when it times out
+func payPremium(ctx wasmlib.ScFuncContext, events htlcEvents, id wasmtypes.ScHash, swap *Swap, recipient wasmtypes.ScAgentID) uint64 {
+    premium := swap.Premium
+    if premium == 0 {
+        return 0
+    }
+    ctx.Require(premium <= ctx.Balances().Iotas(), ErrInsufficientEscrow)
+    payout(ctx, recipient, wasmlib.NewScTransferIotas(premium))
+    events.PremiumPaid(id, recipient, premium)
+    swap.Premium = 0
+    return premium
 }
 
-func funcSetTime(ctx wasmlib.ScFuncContext, f *SetTimeContext) {
-    f.State.Time().SetValue(f.Params.Time().Value())
+// claimMessage is what the receiver signs to direct the escrow of a swap to
+// another agent, it is bound to this chain and this contract so it cannot be
+// replayed on another chain or another deployment with the same terms
+func claimMessage(ctx wasmlib.ScFuncContext, id wasmtypes.ScHash, recipient wasmtypes.ScAgentID) []byte {
+    buf := append(ctx.ChainID().Bytes(), ctx.Contract().Bytes()...)
+    buf = append(buf, id.Bytes()...)
+    return append(buf, recipient.Bytes()...)
+}
+
+// claimRecipient returns the agent the escrow is paid to, the receiver unless
+// the claim redirects it. Only the receiver can redirect, either by claiming
+// itself or by signing the redirect for a relayer.
+func claimRecipient(ctx wasmlib.ScFuncContext, f *ClaimContext, id wasmtypes.ScHash, swap *Swap) wasmtypes.ScAgentID {
+    if !f.Params.Recipient().Exists() {
+        return swap.Receivder
+    }
+    recipient := f.Params.Recipient().Value()
+    ctx.Require(recipient != wasmtypes.ScAgentID{}, "invalid recipient")
+    if ctx.Caller() == swap.Receivder {
+        return recipient
+    }
+    ctx.Require(f.Params.PubKey().Exists() && f.Params.Signature().Exists(), "missing receivder signature")
+    pubKey := f.Params.PubKey().Value()
+    ctx.Require(swap.Receivder.IsAddress() && ctx.Utility().Ed25519AddressFromPubKey(pubKey) == swap.Receivder.Address(), "public key is not the receivder's")
+    signature := f.Params.Signature().Value()
+    ctx.Require(ctx.Utility().Ed25519ValidSignature(claimMessage(ctx, id, recipient), pubKey, signature), "invalid receivder signature")
+    return recipient
+}
+
+// roleMembers returns the members of the named role
+func roleMembers(ctx wasmlib.ScFuncContext, state MutablehtlcState, name string) MapAgentIDToMutableBool {
+    switch name {
+    case RoleOperator:
+        return state.Operators()
+    case RolePauser:
+        return state.Pausers()
+    }
+    ctx.Panic("unknown role: " + name)
+    return MapAgentIDToMutableBool{}
+}
+
+// requireRole fails the request unless the caller is a member of the role
+func requireRole(ctx wasmlib.ScFuncContext, state MutablehtlcState, name string) {
+    ctx.Require(roleMembers(ctx, state, name).GetBool(ctx.Caller()).Value(), "no permission")
+}
+
+// funcInit hands every role to the owner, who can then grant them to other
+// agents
+func funcInit(ctx wasmlib.ScFuncContext, f *InitContext) {
+    owner := ctx.ContractCreator()
+    if f.Params.Owner().Exists() {
+        owner = f.Params.Owner().Value()
+    }
+    f.State.Owner().SetValue(owner)
+    f.State.Operators().GetBool(owner).SetValue(true)
+    f.State.Pausers().GetBool(owner).SetValue(true)
 }
 
-func funcSetValue(ctx wasmlib.ScFuncContext, f *SetValueContext) {
-    f.State.Value().SetValue(f.Params.Value().Value())
+// funcProposeOwner only records the new owner, ownership moves once that
+// agent proves control of its key by calling funcAcceptOwnership
+func funcProposeOwner(ctx wasmlib.ScFuncContext, f *ProposeOwnerContext) {
+    f.State.PendingOwner().SetValue(f.Params.Owner().Value())
 }
 
-func funcTransfer(ctx wasmlib.ScFuncContext, f *TransferContext) {
-    if (time.Now().Unix() <= f.State.InitTime().Value() + f.State.Time().Value()) {
-        if (f.Params.Secret().Value() == f.State.Secret().Value()) {
-            address := wasmtypes.AddressFromBytes(f.State.Receivder().Value().Bytes())
-            transfers := wasmlib.NewScTransferIotas(f.State.Value().Value())
-            ctx.Send(address, transfers)
+// funcAcceptOwnership makes the pending owner the owner. The roles the old
+// owner was a member of move along, so the old owner keeps no permissions.
+func funcAcceptOwnership(ctx wasmlib.ScFuncContext, f *AcceptOwnershipContext) {
+    oldOwner := f.State.Owner().Value()
+    newOwner := f.State.PendingOwner().Value()
+    for _, name := range []string{RoleOperator, RolePauser} {
+        members := roleMembers(ctx, f.State, name)
+        if members.GetBool(oldOwner).Value() {
+            members.GetBool(oldOwner).Delete()
+            members.GetBool(newOwner).SetValue(true)
         }
     }
+    f.State.Owner().SetValue(newOwner)
+    f.State.PendingOwner().Delete()
+}
+
+func funcCancelOwnership(ctx wasmlib.ScFuncContext, f *CancelOwnershipContext) {
+    ctx.Require(f.State.PendingOwner().Exists(), "no ownership transfer pending")
+    f.State.PendingOwner().Delete()
+}
+
+// funcGrantRole adds a member to the role, existing members keep it
+func funcGrantRole(ctx wasmlib.ScFuncContext, f *GrantRoleContext) {
+    member := roleMembers(ctx, f.State, f.Params.Role().Value()).GetBool(f.Params.AgentID().Value())
+    ctx.Require(!member.Value(), "agent already holds the role")
+    member.SetValue(true)
+}
+
+func funcRevokeRole(ctx wasmlib.ScFuncContext, f *RevokeRoleContext) {
+    member := roleMembers(ctx, f.State, f.Params.Role().Value()).GetBool(f.Params.AgentID().Value())
+    ctx.Require(member.Value(), "agent does not hold the role")
+    member.Delete()
+}
+
+// funcPause stops the creation of new swaps, e.g. when a bug is found. Swaps
+// that already exist can still be claimed, refunded or cancelled, so no funds
+// get stuck while the contract is paused.
+func funcPause(ctx wasmlib.ScFuncContext, f *PauseContext) {
+    requireRole(ctx, f.State, RolePauser)
+    f.State.Paused().SetValue(true)
+}
+
+func funcUnpause(ctx wasmlib.ScFuncContext, f *UnpauseContext) {
+    f.State.Paused().Delete()
+}
+
+// funcNewSwap sets up and funds a swap in a single request, so a swap never
+// exists with only part of its terms or without its escrow. There are no
+// setters: once funded, the terms of a swap can never be changed.
+func funcNewSwap(ctx wasmlib.ScFuncContext, f *NewSwapContext) {
+    requireRole(ctx, f.State, RoleOperator)
+    ctx.Require(!f.State.Paused().Value(), ErrPaused)
+    swap := &Swap{
+        Sender:    ctx.Caller(),
+        Receivder: f.Params.Receivder().Value(),
+        Hashlock:  f.Params.Hashlock().Value(),
+        InitTime:  timestamp(ctx),
+        Time:      f.Params.Time().Value(),
+        Value:     ctx.Allowance().Iotas(),
+        Status:    StatusFunded,
+        HashAlgo:  HashBlake2b,
+    }
+    if f.Params.HashAlgo().Exists() {
+        swap.HashAlgo = f.Params.HashAlgo().Value()
+    }
+    if f.Params.RelayerFee().Exists() {
+        swap.RelayerFee = f.Params.RelayerFee().Value()
+    }
+    if f.Params.Collateral().Exists() {
+        swap.Collateral = f.Params.Collateral().Value()
+    }
+    if f.Params.BribeDelay().Exists() {
+        swap.BribeDelay = f.Params.BribeDelay().Value()
+    }
+    if f.Params.Premium().Exists() {
+        swap.Premium = f.Params.Premium().Value()
+    }
+    ctx.Require(swap.Hashlock != wasmtypes.ScHash{}, "invalid hashlock")
+    ctx.Require(swap.HashAlgo <= HashEd25519Point, "invalid hashAlgo")
+    if swap.HashAlgo == HashEd25519Point {
+        _, err := new(edwards25519.Point).SetBytes(swap.Hashlock.Bytes())
+        ctx.Require(err == nil, "invalid hashlock")
+    }
+    ctx.Require(swap.Receivder != wasmtypes.ScAgentID{}, "invalid receivder")
+    ctx.Require(validTime(swap.InitTime, swap.Time), "invalid time")
+    // base tokens are always needed, they cover the storage deposit of the payout
+    ctx.Require(swap.Value > 0, "missing allowance")
+    // the premium is kept aside, only the rest of the allowance is escrowed
+    ctx.Require(swap.Premium < swap.Value, "premium exceeds the allowance")
+    swap.Value -= swap.Premium
+    ctx.Require(swap.RelayerFee < swap.Value, "relayer fee exceeds the escrow")
+    if swap.BribeDelay != 0 {
+        ctx.Require(swap.Collateral > 0, "bribeDelay needs collateral")
+        ctx.Require(swap.BribeDelay > 0 && swap.BribeDelay < swap.Time, "invalid bribeDelay")
+    }
+
+    id := swapID(ctx, swap.Hashlock, swap.Sender, swap.Receivder)
+    entry := f.State.Swaps().GetSwap(id)
+    ctx.Require(!entry.Exists(), "swap already exists, its terms are frozen")
+    lockEscrow(ctx, f.State, id)
+    entry.SetValue(swap)
+    f.Results.SwapID().SetValue(id)
+    f.Events.SwapCreated(id, swap.Sender, swap.Receivder, swap.Hashlock, swap.HashAlgo, swap.InitTime + swap.Time)
+    f.Events.SwapFunded(id, swap.Value, swap.Value)
+}
+
+// funcPostCollateral lets the receiver post the collateral required by the
+// swap, possibly over several requests, while the timelock runs. It is not
+// blocked by a pause, the receiver needs it to be able to claim.
+func funcPostCollateral(ctx wasmlib.ScFuncContext, f *PostCollateralContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    ctx.Require(ctx.Caller() == swap.Receivder, "only receivder can post collateral")
+    ctx.Require(!expired(ctx, swap), ErrExpired)
+    amount := ctx.Allowance().Iotas()
+    ctx.Require(amount > 0, "missing allowance")
+    ctx.Require(swap.CollateralPosted + amount <= swap.Collateral, "collateral exceeds the required amount")
+
+    ctx.TransferAllowed(ctx.AccountID(), wasmlib.NewScTransferIotas(amount), false)
+    swap.CollateralPosted += amount
+    entry.SetValue(swap)
+    f.Events.CollateralPosted(id, amount, swap.CollateralPosted)
+}
+
+// funcClaim can be submitted by anyone who knows the preimage. When someone
+// other than the receiver relays the claim, it earns the relayer fee agreed
+// on in the swap, so receivers do not need to hold gas on every chain. If the
+// swap takes collateral, a claim that only arrives more than the bribe delay
+// after the swap was created is taken as evidence of a bribed delay and
+// forfeits the collateral to the sender, an earlier claim returns it to the
+// receiver. The delay is fixed when the swap is created, so neither party can
+// move it later on.
+func funcClaim(ctx wasmlib.ScFuncContext, f *ClaimContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    ctx.Require(!expired(ctx, swap), ErrExpired)
+    preimage := f.Params.Preimage().Value()
+    ctx.Require(digest(ctx, swap.HashAlgo, preimage) == swap.Hashlock, ErrWrongPreimage)
+    ctx.Require(swap.CollateralPosted >= swap.Collateral, "collateral not posted")
+    recipient := claimRecipient(ctx, f, id, swap)
+
+    fee := uint64(0)
+    if ctx.Caller() != swap.Receivder {
+        fee = swap.RelayerFee
+    }
+    value := swap.Value - fee
+    payout(ctx, recipient, releaseEscrow(ctx, f.State, id, swap, fee))
+    if fee > 0 {
+        payout(ctx, ctx.Caller(), wasmlib.NewScTransferIotas(fee))
+    }
+    forfeit := swap.BribeDelay > 0 && timestamp(ctx) > swap.InitTime + swap.BribeDelay
+    settleCollateral(ctx, f.Events, id, swap, forfeit)
+    premium := payPremium(ctx, f.Events, id, swap, swap.Sender)
+    swap.Value = 0
+    swap.Preimage = preimage
+    swap.Status = StatusClaimed
+    entry.SetValue(swap)
+    f.Results.Recipient().SetValue(recipient)
+    f.Results.Value().SetValue(value)
+    f.Results.Fee().SetValue(fee)
+    f.Results.Premium().SetValue(premium)
+    f.Events.SwapClaimed(id, preimage, value)
 }
 
-func funcWithdraw(ctx wasmlib.ScFuncContext, f *WithdrawContext) {
-    if (time.Now().Unix() > f.State.InitTime().Value() + f.State.Time().Value()) {
-        address := wasmtypes.AddressFromBytes(f.State.Owner().Value().Bytes())
-        transfers := wasmlib.NewScTransferIotas(f.State.Value().Value())
-        ctx.Send(address, transfers)
+// funcRefund can be triggered by anyone once the timelock has passed, the
+// escrow always goes back to the sender who deposited it, any collateral back
+// to the receiver, and the premium to the receiver as its compensation
+func funcRefund(ctx wasmlib.ScFuncContext, f *RefundContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    ctx.Require(expired(ctx, swap), ErrNotExpired)
+
+    value := swap.Value
+    recipient := swap.Sender
+    payout(ctx, recipient, releaseEscrow(ctx, f.State, id, swap, 0))
+    settleCollateral(ctx, f.Events, id, swap, false)
+    premium := payPremium(ctx, f.Events, id, swap, swap.Receivder)
+    swap.Value = 0
+    swap.Status = StatusRefunded
+    entry.SetValue(swap)
+    f.Results.Recipient().SetValue(recipient)
+    f.Results.Value().SetValue(value)
+    f.Results.Premium().SetValue(premium)
+    f.Events.SwapRefunded(id, value)
+}
+
+// funcCancel records that the caller, the sender or the receiver, agrees to
+// call off the swap. Whoever proposes first, the swap is only cancelled when
+// the other party confirms, and the escrow then goes back to the sender
+// without waiting for the timelock.
+func funcCancel(ctx wasmlib.ScFuncContext, f *CancelContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    caller := ctx.Caller()
+    switch caller {
+    case swap.Sender:
+        swap.CancelBy |= CancelSender
+    case swap.Receivder:
+        swap.CancelBy |= CancelReceiver
+    default:
+        ctx.Panic("only sender or receivder can cancel")
+    }
+    f.Events.SwapCancelRequested(id, caller)
+    if swap.CancelBy != CancelSender|CancelReceiver {
+        entry.SetValue(swap)
+        return
     }
+
+    value := swap.Value
+    payout(ctx, swap.Sender, releaseEscrow(ctx, f.State, id, swap, 0))
+    settleCollateral(ctx, f.Events, id, swap, false)
+    payPremium(ctx, f.Events, id, swap, swap.Sender)
+    swap.Value = 0
+    swap.Status = StatusCancelled
+    entry.SetValue(swap)
+    f.Events.SwapCancelled(id, value)
+}
+
+// funcExtendTimelock lets the sender propose a longer timelock, e.g. when the
+// counterparty chain is congested. It only takes effect once the receiver
+// approves it with funcApproveExtension.
+func funcExtendTimelock(ctx wasmlib.ScFuncContext, f *ExtendTimelockContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    ctx.Require(ctx.Caller() == swap.Sender, "only sender can extend the timelock")
+    ctx.Require(!expired(ctx, swap), ErrExpired)
+    time := f.Params.Time().Value()
+    ctx.Require(time > swap.Time, "timelock can only be extended")
+    ctx.Require(validTime(swap.InitTime, time), "invalid time")
+    swap.PendingTime = time
+    entry.SetValue(swap)
+}
+
+// funcApproveExtension applies the extension proposed by the sender. The
+// receiver passes the timelock it agrees to, so the proposal cannot be
+// swapped for another one before the approval lands.
+func funcApproveExtension(ctx wasmlib.ScFuncContext, f *ApproveExtensionContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    ctx.Require(ctx.Caller() == swap.Receivder, "only receivder can approve an extension")
+    ctx.Require(!expired(ctx, swap), ErrExpired)
+    ctx.Require(swap.PendingTime != 0, "no extension proposed")
+    ctx.Require(f.Params.Time().Value() == swap.PendingTime, "extension does not match the proposal")
+    swap.Time = swap.PendingTime
+    swap.PendingTime = 0
+    entry.SetValue(swap)
+    f.Events.SwapExtended(id, swap.InitTime + swap.Time)
 }
 
 func viewGetOwner(ctx wasmlib.ScViewContext, f *GetOwnerContext) {
 	f.Results.Owner().SetValue(f.State.Owner().Value())
+	if f.State.PendingOwner().Exists() {
+		f.Results.PendingOwner().SetValue(f.State.PendingOwner().Value())
+	}
+}
+
+func viewGetPreimage(ctx wasmlib.ScViewContext, f *GetPreimageContext) {
+    swap := f.State.Swaps().GetSwap(f.Params.SwapID().Value())
+    ctx.Require(swap.Exists(), "unknown swap")
+    preimage := swap.Value().Preimage
+    ctx.Require(len(preimage) != 0, "preimage not revealed")
+    f.Results.Preimage().SetValue(preimage)
+}
+
+func viewGetRoles(ctx wasmlib.ScViewContext, f *GetRolesContext) {
+    agent := f.Params.AgentID().Value()
+    f.Results.Admin().SetValue(f.State.Owner().Value() == agent)
+    f.Results.Operator().SetValue(f.State.Operators().GetBool(agent).Value())
+    f.Results.Pauser().SetValue(f.State.Pausers().GetBool(agent).Value())
+}
+
+func viewGetStatus(ctx wasmlib.ScViewContext, f *GetStatusContext) {
+    swap := f.State.Swaps().GetSwap(f.Params.SwapID().Value())
+    ctx.Require(swap.Exists(), "unknown swap")
+    f.Results.Status().SetValue(swap.Value().Status)
+}
+
+func viewGetSwap(ctx wasmlib.ScViewContext, f *GetSwapContext) {
+    id := f.Params.SwapID().Value()
+    swap := f.State.Swaps().GetSwap(id)
+    ctx.Require(swap.Exists(), "unknown swap")
+    f.Results.Swap().SetValue(swap.Value())
+    tokens := f.State.SwapTokens().GetTokenAmounts(id)
+    for i := uint32(0); i < tokens.Length(); i++ {
+        f.Results.Tokens().AppendTokenAmount().SetValue(tokens.GetTokenAmount(i).Value())
+    }
+    nfts := f.State.SwapNfts().GetNftIDs(id)
+    for i := uint32(0); i < nfts.Length(); i++ {
+        f.Results.Nfts().AppendNftID().SetValue(nfts.GetNftID(i).Value())
+    }
 }
 
-func viewGetValue(ctx wasmlib.ScViewContext, f *GetValueContext) {
-    f.Results.Value().SetValue(f.State.Value().Value())
+func viewIsPaused(ctx wasmlib.ScViewContext, f *IsPausedContext) {
+    f.Results.Paused().SetValue(f.State.Paused().Value())
 }
@@ -12,30 +12,66 @@ import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib"
 
 var exportMap = wasmlib.ScExportMap{
 	Names: []string{
+    	FuncAcceptOwnership,
+    	FuncApproveExtension,
+    	FuncCancel,
+    	FuncCancelOwnership,
+    	FuncClaim,
+    	FuncExtendTimelock,
+    	FuncGrantRole,
     	FuncInit,
-    	FuncSetOwner,
-    	FuncSetReceivder,
-    	FuncSetSecret,
-    	FuncSetTime,
-    	FuncSetValue,
-    	FuncTransfer,
-    	FuncWithdraw,
+    	FuncMadClaim,
+    	FuncMadCollateral,
+    	FuncMadNewSwap,
+    	FuncMadRefund,
+    	FuncMadReleaseCollateral,
+    	FuncMadSweep,
+    	FuncNewSwap,
+    	FuncPause,
+    	FuncPostCollateral,
+    	FuncProposeOwner,
+    	FuncRefund,
+    	FuncRevokeRole,
+    	FuncUnpause,
+    	ViewGetMadSwap,
     	ViewGetOwner,
-    	ViewGetValue,
+    	ViewGetPreimage,
+    	ViewGetRoles,
+    	ViewGetStatus,
+    	ViewGetSwap,
+    	ViewIsPaused,
 	},
 	Funcs: []wasmlib.ScFuncContextFunction{
+    	funcAcceptOwnershipThunk,
+    	funcApproveExtensionThunk,
+    	funcCancelThunk,
+    	funcCancelOwnershipThunk,
+    	funcClaimThunk,
+    	funcExtendTimelockThunk,
+    	funcGrantRoleThunk,
     	funcInitThunk,
-    	funcSetOwnerThunk,
-    	funcSetReceivderThunk,
-    	funcSetSecretThunk,
-    	funcSetTimeThunk,
-    	funcSetValueThunk,
-    	funcTransferThunk,
-    	funcWithdrawThunk,
+    	funcMadClaimThunk,
+    	funcMadCollateralThunk,
+    	funcMadNewSwapThunk,
+    	funcMadRefundThunk,
+    	funcMadReleaseCollateralThunk,
+    	funcMadSweepThunk,
+    	funcNewSwapThunk,
+    	funcPauseThunk,
+    	funcPostCollateralThunk,
+    	funcProposeOwnerThunk,
+    	funcRefundThunk,
+    	funcRevokeRoleThunk,
+    	funcUnpauseThunk,
 	},
 	Views: []wasmlib.ScViewContextFunction{
+    	viewGetMadSwapThunk,
     	viewGetOwnerThunk,
-    	viewGetValueThunk,
+    	viewGetPreimageThunk,
+    	viewGetRolesThunk,
+    	viewGetStatusThunk,
+    	viewGetSwapThunk,
+    	viewIsPausedThunk,
 	},
 }
 
@@ -48,185 +84,530 @@ func OnLoad(index int32) {
 	wasmlib.ScExportsExport(&exportMap)
 }
 
-type InitContext struct {
-	Params  ImmutableInitParams
+type AcceptOwnershipContext struct {
+	Events  htlcEvents
 	State   MutablehtlcState
 }
 
-func funcInitThunk(ctx wasmlib.ScFuncContext) {
-	ctx.Log("htlc.funcInit")
-	f := &InitContext{
-		Params: ImmutableInitParams{
+func funcAcceptOwnershipThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcAcceptOwnership")
+	f := &AcceptOwnershipContext{
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+
+	// only the proposed owner can accept
+	access := f.State.PendingOwner()
+	ctx.Require(access.Exists(), "access not set: pendingOwner")
+	ctx.Require(ctx.Caller() == access.Value(), "no permission")
+
+	funcAcceptOwnership(ctx, f)
+	ctx.Log("htlc.funcAcceptOwnership ok")
+}
+
+type ApproveExtensionContext struct {
+	Events  htlcEvents
+	Params  ImmutableApproveExtensionParams
+	State   MutablehtlcState
+}
+
+func funcApproveExtensionThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcApproveExtension")
+	f := &ApproveExtensionContext{
+		Params: ImmutableApproveExtensionParams{
 			proxy: wasmlib.NewParamsProxy(),
 		},
 		State: MutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
-	funcInit(ctx, f)
-	ctx.Log("htlc.funcInit ok")
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	ctx.Require(f.Params.Time().Exists(), "missing mandatory time")
+	funcApproveExtension(ctx, f)
+	ctx.Log("htlc.funcApproveExtension ok")
 }
 
-type SetOwnerContext struct {
-	Params  ImmutableSetOwnerParams
+type CancelContext struct {
+	Events  htlcEvents
+	Params  ImmutableCancelParams
 	State   MutablehtlcState
 }
 
-func funcSetOwnerThunk(ctx wasmlib.ScFuncContext) {
-	ctx.Log("htlc.funcSetOwner")
-	f := &SetOwnerContext{
-		Params: ImmutableSetOwnerParams{
+func funcCancelThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcCancel")
+	f := &CancelContext{
+		Params: ImmutableCancelParams{
 			proxy: wasmlib.NewParamsProxy(),
 		},
 		State: MutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcCancel(ctx, f)
+	ctx.Log("htlc.funcCancel ok")
+}
+
+type CancelOwnershipContext struct {
+	Events  htlcEvents
+	State   MutablehtlcState
+}
+
+func funcCancelOwnershipThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcCancelOwnership")
+	f := &CancelOwnershipContext{
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
 
 	// current owner of this smart contract
 	access := f.State.Owner()
 	ctx.Require(access.Exists(), "access not set: owner")
 	ctx.Require(ctx.Caller() == access.Value(), "no permission")
 
-	ctx.Require(f.Params.Owner().Exists(), "missing mandatory owner")
-	funcSetOwner(ctx, f)
-	ctx.Log("htlc.funcSetOwner ok")
+	funcCancelOwnership(ctx, f)
+	ctx.Log("htlc.funcCancelOwnership ok")
+}
+
+type ClaimContext struct {
+	Events  htlcEvents
+	Params  ImmutableClaimParams
+	Results MutableClaimResults
+	State   MutablehtlcState
 }
 
-type SetReceivderContext struct {
-	Params  ImmutableSetReceivderParams
+func funcClaimThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcClaim")
+	results := wasmlib.NewScDict()
+	f := &ClaimContext{
+		Params: ImmutableClaimParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableClaimResults{
+			proxy: results.AsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.Preimage().Exists(), "missing mandatory preimage")
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcClaim(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.funcClaim ok")
+}
+
+type ExtendTimelockContext struct {
+	Events  htlcEvents
+	Params  ImmutableExtendTimelockParams
+	State   MutablehtlcState
+}
+
+func funcExtendTimelockThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcExtendTimelock")
+	f := &ExtendTimelockContext{
+		Params: ImmutableExtendTimelockParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	ctx.Require(f.Params.Time().Exists(), "missing mandatory time")
+	funcExtendTimelock(ctx, f)
+	ctx.Log("htlc.funcExtendTimelock ok")
+}
+
+type GrantRoleContext struct {
+	Events  htlcEvents
+	Params  ImmutableGrantRoleParams
 	State   MutablehtlcState
 }
 
-func funcSetReceivderThunk(ctx wasmlib.ScFuncContext) {
-	ctx.Log("htlc.funcSetReceivder")
-	f := &SetReceivderContext{
-		Params: ImmutableSetReceivderParams{
+func funcGrantRoleThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcGrantRole")
+	f := &GrantRoleContext{
+		Params: ImmutableGrantRoleParams{
 			proxy: wasmlib.NewParamsProxy(),
 		},
 		State: MutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
+
+	// only the admin can grant roles
 	access := f.State.Owner()
 	ctx.Require(access.Exists(), "access not set: owner")
 	ctx.Require(ctx.Caller() == access.Value(), "no permission")
 
+	ctx.Require(f.Params.AgentID().Exists(), "missing mandatory agentID")
+	ctx.Require(f.Params.Role().Exists(), "missing mandatory role")
+	funcGrantRole(ctx, f)
+	ctx.Log("htlc.funcGrantRole ok")
+}
+
+type InitContext struct {
+	Events  htlcEvents
+	Params  ImmutableInitParams
+	State   MutablehtlcState
+}
+
+func funcInitThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcInit")
+	f := &InitContext{
+		Params: ImmutableInitParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	funcInit(ctx, f)
+	ctx.Log("htlc.funcInit ok")
+}
+
+type MadClaimContext struct {
+	Events  htlcEvents
+	Params  ImmutableMadClaimParams
+	State   MutablehtlcState
+}
+
+func funcMadClaimThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcMadClaim")
+	f := &MadClaimContext{
+		Params: ImmutableMadClaimParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.Preimage().Exists(), "missing mandatory preimage")
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcMadClaim(ctx, f)
+	ctx.Log("htlc.funcMadClaim ok")
+}
+
+type MadCollateralContext struct {
+	Events  htlcEvents
+	Params  ImmutableMadCollateralParams
+	State   MutablehtlcState
+}
+
+func funcMadCollateralThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcMadCollateral")
+	f := &MadCollateralContext{
+		Params: ImmutableMadCollateralParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcMadCollateral(ctx, f)
+	ctx.Log("htlc.funcMadCollateral ok")
+}
+
+type MadNewSwapContext struct {
+	Events  htlcEvents
+	Params  ImmutableMadNewSwapParams
+	Results MutableMadNewSwapResults
+	State   MutablehtlcState
+}
+
+func funcMadNewSwapThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcMadNewSwap")
+	results := wasmlib.NewScDict()
+	f := &MadNewSwapContext{
+		Params: ImmutableMadNewSwapParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableMadNewSwapResults{
+			proxy: results.AsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.Hashlock().Exists(), "missing mandatory hashlock")
 	ctx.Require(f.Params.Receivder().Exists(), "missing mandatory receivder")
-	funcSetReceivder(ctx, f)
-	ctx.Log("htlc.funcSetReceivder ok")
+	ctx.Require(f.Params.Refundlock().Exists(), "missing mandatory refundlock")
+	ctx.Require(f.Params.Time().Exists(), "missing mandatory time")
+	funcMadNewSwap(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.funcMadNewSwap ok")
 }
 
-type SetSecretContext struct {
-	Params  ImmutableSetSecretParams
+type MadRefundContext struct {
+	Events  htlcEvents
+	Params  ImmutableMadRefundParams
 	State   MutablehtlcState
 }
 
-func funcSetSecretThunk(ctx wasmlib.ScFuncContext) {
-	ctx.Log("htlc.funcSetSecret")
-	f := &SetSecretContext{
-		Params: ImmutableSetSecretParams{
+func funcMadRefundThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcMadRefund")
+	f := &MadRefundContext{
+		Params: ImmutableMadRefundParams{
 			proxy: wasmlib.NewParamsProxy(),
 		},
 		State: MutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
-	access := f.State.Owner()
-	ctx.Require(access.Exists(), "access not set: owner")
-	ctx.Require(ctx.Caller() == access.Value(), "no permission")
+	ctx.Require(f.Params.RefundSecret().Exists(), "missing mandatory refundSecret")
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcMadRefund(ctx, f)
+	ctx.Log("htlc.funcMadRefund ok")
+}
+
+type MadReleaseCollateralContext struct {
+	Events  htlcEvents
+	Params  ImmutableMadReleaseCollateralParams
+	State   MutablehtlcState
+}
 
-	ctx.Require(f.Params.Secret().Exists(), "missing mandatory secret")
-	funcSetSecret(ctx, f)
-	ctx.Log("htlc.funcSetSecret ok")
+func funcMadReleaseCollateralThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcMadReleaseCollateral")
+	f := &MadReleaseCollateralContext{
+		Params: ImmutableMadReleaseCollateralParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcMadReleaseCollateral(ctx, f)
+	ctx.Log("htlc.funcMadReleaseCollateral ok")
 }
 
-type SetTimeContext struct {
-	Params  ImmutableSetTimeParams
+type MadSweepContext struct {
+	Events  htlcEvents
+	Params  ImmutableMadSweepParams
+	Results MutableMadSweepResults
 	State   MutablehtlcState
 }
 
-func funcSetTimeThunk(ctx wasmlib.ScFuncContext) {
-	ctx.Log("htlc.funcSetTime")
-	f := &SetTimeContext{
-		Params: ImmutableSetTimeParams{
+func funcMadSweepThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcMadSweep")
+	results := wasmlib.NewScDict()
+	f := &MadSweepContext{
+		Params: ImmutableMadSweepParams{
 			proxy: wasmlib.NewParamsProxy(),
 		},
+		Results: MutableMadSweepResults{
+			proxy: results.AsProxy(),
+		},
 		State: MutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
-	access := f.State.Owner()
-	ctx.Require(access.Exists(), "access not set: owner")
-	ctx.Require(ctx.Caller() == access.Value(), "no permission")
+	ctx.Require(f.Params.Preimage().Exists(), "missing mandatory preimage")
+	ctx.Require(f.Params.RefundSecret().Exists(), "missing mandatory refundSecret")
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcMadSweep(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.funcMadSweep ok")
+}
+
+type NewSwapContext struct {
+	Events  htlcEvents
+	Params  ImmutableNewSwapParams
+	Results MutableNewSwapResults
+	State   MutablehtlcState
+}
 
+func funcNewSwapThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcNewSwap")
+	results := wasmlib.NewScDict()
+	f := &NewSwapContext{
+		Params: ImmutableNewSwapParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableNewSwapResults{
+			proxy: results.AsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.Hashlock().Exists(), "missing mandatory hashlock")
+	ctx.Require(f.Params.Receivder().Exists(), "missing mandatory receivder")
 	ctx.Require(f.Params.Time().Exists(), "missing mandatory time")
-	funcSetTime(ctx, f)
-	ctx.Log("htlc.funcSetTime ok")
+	funcNewSwap(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.funcNewSwap ok")
+}
+
+type PauseContext struct {
+	Events  htlcEvents
+	State   MutablehtlcState
+}
+
+func funcPauseThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcPause")
+	f := &PauseContext{
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	funcPause(ctx, f)
+	ctx.Log("htlc.funcPause ok")
+}
+
+type PostCollateralContext struct {
+	Events  htlcEvents
+	Params  ImmutablePostCollateralParams
+	State   MutablehtlcState
+}
+
+func funcPostCollateralThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcPostCollateral")
+	f := &PostCollateralContext{
+		Params: ImmutablePostCollateralParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcPostCollateral(ctx, f)
+	ctx.Log("htlc.funcPostCollateral ok")
 }
 
-type SetValueContext struct {
-	Params  ImmutableSetValueParams
+type ProposeOwnerContext struct {
+	Events  htlcEvents
+	Params  ImmutableProposeOwnerParams
 	State   MutablehtlcState
 }
 
-func funcSetValueThunk(ctx wasmlib.ScFuncContext) {
-	ctx.Log("htlc.funcSetValue")
-	f := &SetValueContext{
-		Params: ImmutableSetValueParams{
+func funcProposeOwnerThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcProposeOwner")
+	f := &ProposeOwnerContext{
+		Params: ImmutableProposeOwnerParams{
 			proxy: wasmlib.NewParamsProxy(),
 		},
 		State: MutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
+
+	// current owner of this smart contract
 	access := f.State.Owner()
 	ctx.Require(access.Exists(), "access not set: owner")
 	ctx.Require(ctx.Caller() == access.Value(), "no permission")
 
-	ctx.Require(f.Params.Value().Exists(), "missing mandatory value")
-	funcSetValue(ctx, f)
-	ctx.Log("htlc.funcSetValue ok")
+	ctx.Require(f.Params.Owner().Exists(), "missing mandatory owner")
+	funcProposeOwner(ctx, f)
+	ctx.Log("htlc.funcProposeOwner ok")
+}
+
+type RefundContext struct {
+	Events  htlcEvents
+	Params  ImmutableRefundParams
+	Results MutableRefundResults
+	State   MutablehtlcState
+}
+
+func funcRefundThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcRefund")
+	results := wasmlib.NewScDict()
+	f := &RefundContext{
+		Params: ImmutableRefundParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableRefundResults{
+			proxy: results.AsProxy(),
+		},
+		State: MutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	funcRefund(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.funcRefund ok")
 }
 
-type TransferContext struct {
-	Params  ImmutableTransferParams
+type RevokeRoleContext struct {
+	Events  htlcEvents
+	Params  ImmutableRevokeRoleParams
 	State   MutablehtlcState
 }
 
-func funcTransferThunk(ctx wasmlib.ScFuncContext) {
-	ctx.Log("htlc.funcTransfer")
-	f := &TransferContext{
-		Params: ImmutableTransferParams{
+func funcRevokeRoleThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcRevokeRole")
+	f := &RevokeRoleContext{
+		Params: ImmutableRevokeRoleParams{
 			proxy: wasmlib.NewParamsProxy(),
 		},
 		State: MutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
-	ctx.Require(f.Params.Key().Exists(), "missing mandatory key")
-	ctx.Require(f.Params.Secret().Exists(), "missing mandatory secret")
-	funcTransfer(ctx, f)
-	ctx.Log("htlc.funcTransfer ok")
+
+	// only the admin can revoke roles
+	access := f.State.Owner()
+	ctx.Require(access.Exists(), "access not set: owner")
+	ctx.Require(ctx.Caller() == access.Value(), "no permission")
+
+	ctx.Require(f.Params.AgentID().Exists(), "missing mandatory agentID")
+	ctx.Require(f.Params.Role().Exists(), "missing mandatory role")
+	funcRevokeRole(ctx, f)
+	ctx.Log("htlc.funcRevokeRole ok")
 }
 
-type WithdrawContext struct {
+type UnpauseContext struct {
+	Events  htlcEvents
 	State   MutablehtlcState
 }
 
-func funcWithdrawThunk(ctx wasmlib.ScFuncContext) {
-	ctx.Log("htlc.funcWithdraw")
-	f := &WithdrawContext{
+func funcUnpauseThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("htlc.funcUnpause")
+	f := &UnpauseContext{
 		State: MutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
+
+	// only the admin can unpause the contract
 	access := f.State.Owner()
 	ctx.Require(access.Exists(), "access not set: owner")
 	ctx.Require(ctx.Caller() == access.Value(), "no permission")
 
-	funcWithdraw(ctx, f)
-	ctx.Log("htlc.funcWithdraw ok")
+	funcUnpause(ctx, f)
+	ctx.Log("htlc.funcUnpause ok")
+}
+
+type GetMadSwapContext struct {
+	Params  ImmutableGetMadSwapParams
+	Results MutableGetMadSwapResults
+	State   ImmutablehtlcState
+}
+
+func viewGetMadSwapThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("htlc.viewGetMadSwap")
+	results := wasmlib.NewScDict()
+	f := &GetMadSwapContext{
+		Params: ImmutableGetMadSwapParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableGetMadSwapResults{
+			proxy: results.AsProxy(),
+		},
+		State: ImmutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	viewGetMadSwap(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.viewGetMadSwap ok")
 }
 
 type GetOwnerContext struct {
@@ -250,23 +631,127 @@ func viewGetOwnerThunk(ctx wasmlib.ScViewContext) {
 	ctx.Log("htlc.viewGetOwner ok")
 }
 
-type GetValueContext struct {
-	Results MutableGetValueResults
+type GetPreimageContext struct {
+	Params  ImmutableGetPreimageParams
+	Results MutableGetPreimageResults
+	State   ImmutablehtlcState
+}
+
+func viewGetPreimageThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("htlc.viewGetPreimage")
+	results := wasmlib.NewScDict()
+	f := &GetPreimageContext{
+		Params: ImmutableGetPreimageParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableGetPreimageResults{
+			proxy: results.AsProxy(),
+		},
+		State: ImmutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	viewGetPreimage(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.viewGetPreimage ok")
+}
+
+type GetRolesContext struct {
+	Params  ImmutableGetRolesParams
+	Results MutableGetRolesResults
+	State   ImmutablehtlcState
+}
+
+func viewGetRolesThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("htlc.viewGetRoles")
+	results := wasmlib.NewScDict()
+	f := &GetRolesContext{
+		Params: ImmutableGetRolesParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableGetRolesResults{
+			proxy: results.AsProxy(),
+		},
+		State: ImmutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.AgentID().Exists(), "missing mandatory agentID")
+	viewGetRoles(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.viewGetRoles ok")
+}
+
+type GetStatusContext struct {
+	Params  ImmutableGetStatusParams
+	Results MutableGetStatusResults
+	State   ImmutablehtlcState
+}
+
+func viewGetStatusThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("htlc.viewGetStatus")
+	results := wasmlib.NewScDict()
+	f := &GetStatusContext{
+		Params: ImmutableGetStatusParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableGetStatusResults{
+			proxy: results.AsProxy(),
+		},
+		State: ImmutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	viewGetStatus(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.viewGetStatus ok")
+}
+
+type GetSwapContext struct {
+	Params  ImmutableGetSwapParams
+	Results MutableGetSwapResults
+	State   ImmutablehtlcState
+}
+
+func viewGetSwapThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("htlc.viewGetSwap")
+	results := wasmlib.NewScDict()
+	f := &GetSwapContext{
+		Params: ImmutableGetSwapParams{
+			proxy: wasmlib.NewParamsProxy(),
+		},
+		Results: MutableGetSwapResults{
+			proxy: results.AsProxy(),
+		},
+		State: ImmutablehtlcState{
+			proxy: wasmlib.NewStateProxy(),
+		},
+	}
+	ctx.Require(f.Params.SwapID().Exists(), "missing mandatory swapID")
+	viewGetSwap(ctx, f)
+	ctx.Results(results)
+	ctx.Log("htlc.viewGetSwap ok")
+}
+
+type IsPausedContext struct {
+	Results MutableIsPausedResults
 	State   ImmutablehtlcState
 }
 
-func viewGetValueThunk(ctx wasmlib.ScViewContext) {
-	ctx.Log("htlc.viewGetValue")
+func viewIsPausedThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("htlc.viewIsPaused")
 	results := wasmlib.NewScDict()
-	f := &GetValueContext{
-		Results: MutableGetValueResults{
+	f := &IsPausedContext{
+		Results: MutableIsPausedResults{
 			proxy: results.AsProxy(),
 		},
 		State: ImmutablehtlcState{
 			proxy: wasmlib.NewStateProxy(),
 		},
 	}
-	viewGetValue(ctx, f)
+	viewIsPaused(ctx, f)
 	ctx.Results(results)
-	ctx.Log("htlc.viewGetValue ok")
+	ctx.Log("htlc.viewIsPaused ok")
 }
@@ -0,0 +1,185 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package htlc
+
+import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib"
+import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
+
+// MAD-HTLC swaps make the HTLC resistant to bribery. Next to the hashlock the
+// sender commits to a refund secret, which it has to reveal to get its deposit
+// back. Once both secrets are known anyone can sweep the deposit and the
+// sender's collateral, so bribing the committee to censor a claim until the
+// timelock expires only hands the funds to whoever sweeps them first.
+//
+// This only holds when the sender does not know the claim secret, i.e. on the
+// leg of a swap where the receiver chose the hashlock. A sender that knows
+// both secrets can sweep its own funds at any time.
+
+// madSwapID derives the key of a MAD-HTLC swap from both locks and both
+// parties
+func madSwapID(ctx wasmlib.ScFuncContext, hashlock, refundlock wasmtypes.ScHash, sender, receivder wasmtypes.ScAgentID) wasmtypes.ScHash {
+    buf := append(hashlock.Bytes(), refundlock.Bytes()...)
+    buf = append(buf, sender.Bytes()...)
+    buf = append(buf, receivder.Bytes()...)
+    return ctx.Utility().HashBlake2b(buf)
+}
+
+// existingMadSwap returns the MAD-HTLC swap addressed by id, failing the
+// request when there is no such swap
+func existingMadSwap(ctx wasmlib.ScFuncContext, state MutablehtlcState, id wasmtypes.ScHash) MutableMadSwap {
+    swap := state.MadSwaps().GetMadSwap(id)
+    ctx.Require(swap.Exists(), "unknown swap")
+    return swap
+}
+
+// madExpired tells whether the timelock of the MAD-HTLC swap has passed
+func madExpired(ctx wasmlib.ScFuncContext, swap *MadSwap) bool {
+    return timestamp(ctx) > swap.InitTime + swap.Time
+}
+
+// madPayout pays iotas the contract holds for a MAD-HTLC swap to an agent
+func madPayout(ctx wasmlib.ScFuncContext, agent wasmtypes.ScAgentID, value uint64) {
+    ctx.Require(value > 0 && value <= ctx.Balances().Iotas(), ErrInsufficientEscrow)
+    payout(ctx, agent, wasmlib.NewScTransferIotas(value))
+}
+
+func funcMadNewSwap(ctx wasmlib.ScFuncContext, f *MadNewSwapContext) {
+    requireRole(ctx, f.State, RoleOperator)
+    ctx.Require(!f.State.Paused().Value(), ErrPaused)
+    swap := &MadSwap{
+        Sender:     ctx.Caller(),
+        Receivder:  f.Params.Receivder().Value(),
+        Hashlock:   f.Params.Hashlock().Value(),
+        Refundlock: f.Params.Refundlock().Value(),
+        InitTime:   timestamp(ctx),
+        Time:       f.Params.Time().Value(),
+        Value:      ctx.Allowance().Iotas(),
+        Status:     StatusFunded,
+    }
+    ctx.Require(swap.Hashlock != wasmtypes.ScHash{}, "invalid hashlock")
+    ctx.Require(swap.Refundlock != wasmtypes.ScHash{} && swap.Refundlock != swap.Hashlock, "invalid refundlock")
+    ctx.Require(swap.Receivder != wasmtypes.ScAgentID{}, "invalid receivder")
+    ctx.Require(validTime(swap.InitTime, swap.Time), "invalid time")
+    ctx.Require(swap.Value > 0, "missing allowance")
+
+    id := madSwapID(ctx, swap.Hashlock, swap.Refundlock, swap.Sender, swap.Receivder)
+    entry := f.State.MadSwaps().GetMadSwap(id)
+    ctx.Require(!entry.Exists(), "swap already exists, its terms are frozen")
+    ctx.TransferAllowed(ctx.AccountID(), wasmlib.NewScTransferIotas(swap.Value), false)
+    entry.SetValue(swap)
+    f.Results.SwapID().SetValue(id)
+    f.Events.MadSwapCreated(id, swap.Sender, swap.Receivder, swap.Hashlock, swap.Refundlock, swap.InitTime + swap.Time)
+}
+
+// funcMadCollateral lets the sender put collateral at stake while the swap
+// runs, it can be swept together with the deposit until it is released
+func funcMadCollateral(ctx wasmlib.ScFuncContext, f *MadCollateralContext) {
+    ctx.Require(!f.State.Paused().Value(), ErrPaused)
+    id := f.Params.SwapID().Value()
+    entry := existingMadSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    ctx.Require(ctx.Caller() == swap.Sender, "only sender can post collateral")
+    ctx.Require(!madExpired(ctx, swap), ErrExpired)
+    amount := ctx.Allowance().Iotas()
+    ctx.Require(amount > 0, "missing allowance")
+
+    ctx.TransferAllowed(ctx.AccountID(), wasmlib.NewScTransferIotas(amount), false)
+    swap.Collateral += amount
+    entry.SetValue(swap)
+    f.Events.MadCollateralPosted(id, amount, swap.Collateral)
+}
+
+func funcMadClaim(ctx wasmlib.ScFuncContext, f *MadClaimContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingMadSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    ctx.Require(!madExpired(ctx, swap), ErrExpired)
+    preimage := f.Params.Preimage().Value()
+    ctx.Require(ctx.Utility().HashBlake2b(preimage) == swap.Hashlock, ErrWrongPreimage)
+
+    value := swap.Value
+    madPayout(ctx, swap.Receivder, value)
+    swap.Value = 0
+    swap.Preimage = preimage
+    swap.Status = StatusClaimed
+    entry.SetValue(swap)
+    f.Events.MadSwapClaimed(id, preimage, value)
+}
+
+// funcMadRefund pays the deposit back to the sender once the timelock has
+// passed, but only against the refund secret. Anyone who has also seen the
+// claim secret can then sweep whatever is still at stake.
+func funcMadRefund(ctx wasmlib.ScFuncContext, f *MadRefundContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingMadSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded)
+    ctx.Require(madExpired(ctx, swap), ErrNotExpired)
+    refundSecret := f.Params.RefundSecret().Value()
+    ctx.Require(ctx.Utility().HashBlake2b(refundSecret) == swap.Refundlock, ErrWrongRefundSecret)
+
+    value := swap.Value
+    madPayout(ctx, swap.Sender, value)
+    swap.Value = 0
+    swap.RefundSecret = refundSecret
+    swap.Status = StatusRefunded
+    entry.SetValue(swap)
+    f.Events.MadSwapRefunded(id, refundSecret, value)
+}
+
+// funcMadReleaseCollateral pays the collateral back to the sender once the
+// swap has settled and its timelock has passed without it being swept
+func funcMadReleaseCollateral(ctx wasmlib.ScFuncContext, f *MadReleaseCollateralContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingMadSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusClaimed, StatusRefunded)
+    ctx.Require(madExpired(ctx, swap), ErrNotExpired)
+    ctx.Require(swap.Collateral > 0, "no collateral posted")
+
+    collateral := swap.Collateral
+    madPayout(ctx, swap.Sender, collateral)
+    swap.Collateral = 0
+    entry.SetValue(swap)
+    f.Events.MadCollateralReleased(id, collateral)
+}
+
+// funcMadSweep pays the deposit, if it is still held, and the collateral to
+// whoever presents both secrets, e.g. a committee node that sees a claim and
+// a refund for the same swap. Once the receiver has claimed there is nothing
+// left to punish, so the collateral can no longer be swept.
+func funcMadSweep(ctx wasmlib.ScFuncContext, f *MadSweepContext) {
+    id := f.Params.SwapID().Value()
+    entry := existingMadSwap(ctx, f.State, id)
+    swap := entry.Value()
+    requireStatus(ctx, swap.Status, StatusFunded, StatusRefunded)
+    preimage := f.Params.Preimage().Value()
+    ctx.Require(ctx.Utility().HashBlake2b(preimage) == swap.Hashlock, ErrWrongPreimage)
+    refundSecret := f.Params.RefundSecret().Value()
+    ctx.Require(ctx.Utility().HashBlake2b(refundSecret) == swap.Refundlock, ErrWrongRefundSecret)
+
+    value := swap.Collateral
+    if swap.Status == StatusFunded {
+        value += swap.Value
+    }
+    ctx.Require(value > 0, ErrAlreadySettled)
+    sweeper := ctx.Caller()
+    madPayout(ctx, sweeper, value)
+    swap.Value = 0
+    swap.Collateral = 0
+    swap.Preimage = preimage
+    swap.RefundSecret = refundSecret
+    swap.Status = StatusSwept
+    entry.SetValue(swap)
+    f.Results.Value().SetValue(value)
+    f.Events.MadSwapSwept(id, sweeper, value)
+}
+
+func viewGetMadSwap(ctx wasmlib.ScViewContext, f *GetMadSwapContext) {
+    swap := f.State.MadSwaps().GetMadSwap(f.Params.SwapID().Value())
+    ctx.Require(swap.Exists(), "unknown swap")
+    f.Results.Swap().SetValue(swap.Value())
+}
@@ -9,6 +9,142 @@ package htlc
 
 import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
 
+type ImmutableApproveExtensionParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableApproveExtensionParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+func (s ImmutableApproveExtensionParams) Time() wasmtypes.ScImmutableInt64 {
+	return wasmtypes.NewScImmutableInt64(s.proxy.Root(ParamTime))
+}
+
+type MutableApproveExtensionParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableApproveExtensionParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+func (s MutableApproveExtensionParams) Time() wasmtypes.ScMutableInt64 {
+	return wasmtypes.NewScMutableInt64(s.proxy.Root(ParamTime))
+}
+
+type ImmutableCancelParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableCancelParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutableCancelParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableCancelParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableClaimParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableClaimParams) Preimage() wasmtypes.ScImmutableBytes {
+	return wasmtypes.NewScImmutableBytes(s.proxy.Root(ParamPreimage))
+}
+
+func (s ImmutableClaimParams) PubKey() wasmtypes.ScImmutableBytes {
+	return wasmtypes.NewScImmutableBytes(s.proxy.Root(ParamPubKey))
+}
+
+func (s ImmutableClaimParams) Recipient() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ParamRecipient))
+}
+
+func (s ImmutableClaimParams) Signature() wasmtypes.ScImmutableBytes {
+	return wasmtypes.NewScImmutableBytes(s.proxy.Root(ParamSignature))
+}
+
+func (s ImmutableClaimParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutableClaimParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableClaimParams) Preimage() wasmtypes.ScMutableBytes {
+	return wasmtypes.NewScMutableBytes(s.proxy.Root(ParamPreimage))
+}
+
+func (s MutableClaimParams) PubKey() wasmtypes.ScMutableBytes {
+	return wasmtypes.NewScMutableBytes(s.proxy.Root(ParamPubKey))
+}
+
+func (s MutableClaimParams) Recipient() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamRecipient))
+}
+
+func (s MutableClaimParams) Signature() wasmtypes.ScMutableBytes {
+	return wasmtypes.NewScMutableBytes(s.proxy.Root(ParamSignature))
+}
+
+func (s MutableClaimParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableExtendTimelockParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableExtendTimelockParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+func (s ImmutableExtendTimelockParams) Time() wasmtypes.ScImmutableInt64 {
+	return wasmtypes.NewScImmutableInt64(s.proxy.Root(ParamTime))
+}
+
+type MutableExtendTimelockParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableExtendTimelockParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+func (s MutableExtendTimelockParams) Time() wasmtypes.ScMutableInt64 {
+	return wasmtypes.NewScMutableInt64(s.proxy.Root(ParamTime))
+}
+
+type ImmutableGrantRoleParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGrantRoleParams) AgentID() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ParamAgentID))
+}
+
+func (s ImmutableGrantRoleParams) Role() wasmtypes.ScImmutableString {
+	return wasmtypes.NewScImmutableString(s.proxy.Root(ParamRole))
+}
+
+type MutableGrantRoleParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGrantRoleParams) AgentID() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamAgentID))
+}
+
+func (s MutableGrantRoleParams) Role() wasmtypes.ScMutableString {
+	return wasmtypes.NewScMutableString(s.proxy.Root(ParamRole))
+}
+
 type ImmutableInitParams struct {
 	proxy wasmtypes.Proxy
 }
@@ -25,106 +161,378 @@ func (s MutableInitParams) Owner() wasmtypes.ScMutableAgentID {
 	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamOwner))
 }
 
-type ImmutableSetOwnerParams struct {
+type ImmutableMadClaimParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s ImmutableSetOwnerParams) Owner() wasmtypes.ScImmutableAgentID {
-	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ParamOwner))
+func (s ImmutableMadClaimParams) Preimage() wasmtypes.ScImmutableBytes {
+	return wasmtypes.NewScImmutableBytes(s.proxy.Root(ParamPreimage))
+}
+
+func (s ImmutableMadClaimParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
 }
 
-type MutableSetOwnerParams struct {
+type MutableMadClaimParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s MutableSetOwnerParams) Owner() wasmtypes.ScMutableAgentID {
-	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamOwner))
+func (s MutableMadClaimParams) Preimage() wasmtypes.ScMutableBytes {
+	return wasmtypes.NewScMutableBytes(s.proxy.Root(ParamPreimage))
+}
+
+func (s MutableMadClaimParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableMadCollateralParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableMadCollateralParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutableMadCollateralParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableMadCollateralParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableMadNewSwapParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableMadNewSwapParams) Hashlock() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamHashlock))
+}
+
+func (s ImmutableMadNewSwapParams) Receivder() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ParamReceivder))
+}
+
+func (s ImmutableMadNewSwapParams) Refundlock() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamRefundlock))
+}
+
+func (s ImmutableMadNewSwapParams) Time() wasmtypes.ScImmutableInt64 {
+	return wasmtypes.NewScImmutableInt64(s.proxy.Root(ParamTime))
+}
+
+type MutableMadNewSwapParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableMadNewSwapParams) Hashlock() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamHashlock))
+}
+
+func (s MutableMadNewSwapParams) Receivder() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamReceivder))
+}
+
+func (s MutableMadNewSwapParams) Refundlock() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamRefundlock))
+}
+
+func (s MutableMadNewSwapParams) Time() wasmtypes.ScMutableInt64 {
+	return wasmtypes.NewScMutableInt64(s.proxy.Root(ParamTime))
 }
 
-type ImmutableSetReceivderParams struct {
+type ImmutableMadRefundParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s ImmutableSetReceivderParams) Receivder() wasmtypes.ScImmutableAddress {
-	return wasmtypes.NewScImmutableAddress(s.proxy.Root(ParamReceivder))
+func (s ImmutableMadRefundParams) RefundSecret() wasmtypes.ScImmutableBytes {
+	return wasmtypes.NewScImmutableBytes(s.proxy.Root(ParamRefundSecret))
 }
 
-type MutableSetReceivderParams struct {
+func (s ImmutableMadRefundParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutableMadRefundParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableMadRefundParams) RefundSecret() wasmtypes.ScMutableBytes {
+	return wasmtypes.NewScMutableBytes(s.proxy.Root(ParamRefundSecret))
+}
+
+func (s MutableMadRefundParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableMadReleaseCollateralParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s MutableSetReceivderParams) Receivder() wasmtypes.ScMutableAddress {
-	return wasmtypes.NewScMutableAddress(s.proxy.Root(ParamReceivder))
+func (s ImmutableMadReleaseCollateralParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
 }
 
-type ImmutableSetSecretParams struct {
+type MutableMadReleaseCollateralParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s ImmutableSetSecretParams) Secret() wasmtypes.ScImmutableHash {
-	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSecret))
+func (s MutableMadReleaseCollateralParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
 }
 
-type MutableSetSecretParams struct {
+type ImmutableMadSweepParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s MutableSetSecretParams) Secret() wasmtypes.ScMutableHash {
-	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSecret))
+func (s ImmutableMadSweepParams) Preimage() wasmtypes.ScImmutableBytes {
+	return wasmtypes.NewScImmutableBytes(s.proxy.Root(ParamPreimage))
+}
+
+func (s ImmutableMadSweepParams) RefundSecret() wasmtypes.ScImmutableBytes {
+	return wasmtypes.NewScImmutableBytes(s.proxy.Root(ParamRefundSecret))
+}
+
+func (s ImmutableMadSweepParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
 }
 
-type ImmutableSetTimeParams struct {
+type MutableMadSweepParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s ImmutableSetTimeParams) Time() wasmtypes.ScImmutableInt64 {
+func (s MutableMadSweepParams) Preimage() wasmtypes.ScMutableBytes {
+	return wasmtypes.NewScMutableBytes(s.proxy.Root(ParamPreimage))
+}
+
+func (s MutableMadSweepParams) RefundSecret() wasmtypes.ScMutableBytes {
+	return wasmtypes.NewScMutableBytes(s.proxy.Root(ParamRefundSecret))
+}
+
+func (s MutableMadSweepParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableNewSwapParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableNewSwapParams) BribeDelay() wasmtypes.ScImmutableInt64 {
+	return wasmtypes.NewScImmutableInt64(s.proxy.Root(ParamBribeDelay))
+}
+
+func (s ImmutableNewSwapParams) Collateral() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ParamCollateral))
+}
+
+func (s ImmutableNewSwapParams) HashAlgo() wasmtypes.ScImmutableUint8 {
+	return wasmtypes.NewScImmutableUint8(s.proxy.Root(ParamHashAlgo))
+}
+
+func (s ImmutableNewSwapParams) Hashlock() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamHashlock))
+}
+
+func (s ImmutableNewSwapParams) Premium() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ParamPremium))
+}
+
+func (s ImmutableNewSwapParams) Receivder() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ParamReceivder))
+}
+
+func (s ImmutableNewSwapParams) RelayerFee() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ParamRelayerFee))
+}
+
+func (s ImmutableNewSwapParams) Time() wasmtypes.ScImmutableInt64 {
 	return wasmtypes.NewScImmutableInt64(s.proxy.Root(ParamTime))
 }
 
-type MutableSetTimeParams struct {
+type MutableNewSwapParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s MutableSetTimeParams) Time() wasmtypes.ScMutableInt64 {
+func (s MutableNewSwapParams) BribeDelay() wasmtypes.ScMutableInt64 {
+	return wasmtypes.NewScMutableInt64(s.proxy.Root(ParamBribeDelay))
+}
+
+func (s MutableNewSwapParams) Collateral() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ParamCollateral))
+}
+
+func (s MutableNewSwapParams) HashAlgo() wasmtypes.ScMutableUint8 {
+	return wasmtypes.NewScMutableUint8(s.proxy.Root(ParamHashAlgo))
+}
+
+func (s MutableNewSwapParams) Hashlock() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamHashlock))
+}
+
+func (s MutableNewSwapParams) Premium() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ParamPremium))
+}
+
+func (s MutableNewSwapParams) Receivder() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamReceivder))
+}
+
+func (s MutableNewSwapParams) RelayerFee() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ParamRelayerFee))
+}
+
+func (s MutableNewSwapParams) Time() wasmtypes.ScMutableInt64 {
 	return wasmtypes.NewScMutableInt64(s.proxy.Root(ParamTime))
 }
 
-type ImmutableSetValueParams struct {
+type ImmutablePostCollateralParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutablePostCollateralParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutablePostCollateralParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutablePostCollateralParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableProposeOwnerParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableProposeOwnerParams) Owner() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ParamOwner))
+}
+
+type MutableProposeOwnerParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableProposeOwnerParams) Owner() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamOwner))
+}
+
+type ImmutableRefundParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s ImmutableSetValueParams) Value() wasmtypes.ScImmutableUint64 {
-	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ParamValue))
+func (s ImmutableRefundParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
 }
 
-type MutableSetValueParams struct {
+type MutableRefundParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s MutableSetValueParams) Value() wasmtypes.ScMutableUint64 {
-	return wasmtypes.NewScMutableUint64(s.proxy.Root(ParamValue))
+func (s MutableRefundParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
 }
 
-type ImmutableTransferParams struct {
+type ImmutableRevokeRoleParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s ImmutableTransferParams) Key() wasmtypes.ScImmutableHash {
-	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamKey))
+func (s ImmutableRevokeRoleParams) AgentID() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ParamAgentID))
 }
 
-func (s ImmutableTransferParams) Secret() wasmtypes.ScImmutableHash {
-	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSecret))
+func (s ImmutableRevokeRoleParams) Role() wasmtypes.ScImmutableString {
+	return wasmtypes.NewScImmutableString(s.proxy.Root(ParamRole))
 }
 
-type MutableTransferParams struct {
+type MutableRevokeRoleParams struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s MutableTransferParams) Key() wasmtypes.ScMutableHash {
-	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamKey))
+func (s MutableRevokeRoleParams) AgentID() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamAgentID))
+}
+
+func (s MutableRevokeRoleParams) Role() wasmtypes.ScMutableString {
+	return wasmtypes.NewScMutableString(s.proxy.Root(ParamRole))
+}
+
+type ImmutableGetMadSwapParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetMadSwapParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutableGetMadSwapParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGetMadSwapParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableGetPreimageParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetPreimageParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutableGetPreimageParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGetPreimageParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableGetRolesParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetRolesParams) AgentID() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ParamAgentID))
+}
+
+type MutableGetRolesParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGetRolesParams) AgentID() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ParamAgentID))
+}
+
+type ImmutableGetStatusParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetStatusParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutableGetStatusParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGetStatusParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type ImmutableGetSwapParams struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetSwapParams) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ParamSwapID))
+}
+
+type MutableGetSwapParams struct {
+	proxy wasmtypes.Proxy
 }
 
-func (s MutableTransferParams) Secret() wasmtypes.ScMutableHash {
-	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSecret))
+func (s MutableGetSwapParams) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ParamSwapID))
 }
@@ -9,6 +9,142 @@ package htlc
 
 import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
 
+type ImmutableClaimResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableClaimResults) Fee() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ResultFee))
+}
+
+func (s ImmutableClaimResults) Premium() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ResultPremium))
+}
+
+func (s ImmutableClaimResults) Recipient() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ResultRecipient))
+}
+
+func (s ImmutableClaimResults) Value() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ResultValue))
+}
+
+type MutableClaimResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableClaimResults) Fee() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ResultFee))
+}
+
+func (s MutableClaimResults) Premium() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ResultPremium))
+}
+
+func (s MutableClaimResults) Recipient() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ResultRecipient))
+}
+
+func (s MutableClaimResults) Value() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ResultValue))
+}
+
+type ImmutableMadNewSwapResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableMadNewSwapResults) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ResultSwapID))
+}
+
+type MutableMadNewSwapResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableMadNewSwapResults) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ResultSwapID))
+}
+
+type ImmutableMadSweepResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableMadSweepResults) Value() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ResultValue))
+}
+
+type MutableMadSweepResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableMadSweepResults) Value() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ResultValue))
+}
+
+type ImmutableNewSwapResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableNewSwapResults) SwapID() wasmtypes.ScImmutableHash {
+	return wasmtypes.NewScImmutableHash(s.proxy.Root(ResultSwapID))
+}
+
+type MutableNewSwapResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableNewSwapResults) SwapID() wasmtypes.ScMutableHash {
+	return wasmtypes.NewScMutableHash(s.proxy.Root(ResultSwapID))
+}
+
+type ImmutableRefundResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableRefundResults) Premium() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ResultPremium))
+}
+
+func (s ImmutableRefundResults) Recipient() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ResultRecipient))
+}
+
+func (s ImmutableRefundResults) Value() wasmtypes.ScImmutableUint64 {
+	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ResultValue))
+}
+
+type MutableRefundResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableRefundResults) Premium() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ResultPremium))
+}
+
+func (s MutableRefundResults) Recipient() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ResultRecipient))
+}
+
+func (s MutableRefundResults) Value() wasmtypes.ScMutableUint64 {
+	return wasmtypes.NewScMutableUint64(s.proxy.Root(ResultValue))
+}
+
+type ImmutableGetMadSwapResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetMadSwapResults) Swap() ImmutableMadSwap {
+	return ImmutableMadSwap{proxy: s.proxy.Root(ResultSwap)}
+}
+
+type MutableGetMadSwapResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGetMadSwapResults) Swap() MutableMadSwap {
+	return MutableMadSwap{proxy: s.proxy.Root(ResultSwap)}
+}
+
 type ImmutableGetOwnerResults struct {
 	proxy wasmtypes.Proxy
 }
@@ -17,6 +153,10 @@ func (s ImmutableGetOwnerResults) Owner() wasmtypes.ScImmutableAgentID {
 	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ResultOwner))
 }
 
+func (s ImmutableGetOwnerResults) PendingOwner() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(ResultPendingOwner))
+}
+
 type MutableGetOwnerResults struct {
 	proxy wasmtypes.Proxy
 }
@@ -25,18 +165,118 @@ func (s MutableGetOwnerResults) Owner() wasmtypes.ScMutableAgentID {
 	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ResultOwner))
 }
 
-type ImmutableGetValueResults struct {
+func (s MutableGetOwnerResults) PendingOwner() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(ResultPendingOwner))
+}
+
+type ImmutableGetPreimageResults struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s ImmutableGetValueResults) Value() wasmtypes.ScImmutableUint64 {
-	return wasmtypes.NewScImmutableUint64(s.proxy.Root(ResultValue))
+func (s ImmutableGetPreimageResults) Preimage() wasmtypes.ScImmutableBytes {
+	return wasmtypes.NewScImmutableBytes(s.proxy.Root(ResultPreimage))
 }
 
-type MutableGetValueResults struct {
+type MutableGetPreimageResults struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s MutableGetValueResults) Value() wasmtypes.ScMutableUint64 {
-	return wasmtypes.NewScMutableUint64(s.proxy.Root(ResultValue))
+func (s MutableGetPreimageResults) Preimage() wasmtypes.ScMutableBytes {
+	return wasmtypes.NewScMutableBytes(s.proxy.Root(ResultPreimage))
+}
+
+type ImmutableGetRolesResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetRolesResults) Admin() wasmtypes.ScImmutableBool {
+	return wasmtypes.NewScImmutableBool(s.proxy.Root(ResultAdmin))
+}
+
+func (s ImmutableGetRolesResults) Operator() wasmtypes.ScImmutableBool {
+	return wasmtypes.NewScImmutableBool(s.proxy.Root(ResultOperator))
+}
+
+func (s ImmutableGetRolesResults) Pauser() wasmtypes.ScImmutableBool {
+	return wasmtypes.NewScImmutableBool(s.proxy.Root(ResultPauser))
+}
+
+type MutableGetRolesResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGetRolesResults) Admin() wasmtypes.ScMutableBool {
+	return wasmtypes.NewScMutableBool(s.proxy.Root(ResultAdmin))
+}
+
+func (s MutableGetRolesResults) Operator() wasmtypes.ScMutableBool {
+	return wasmtypes.NewScMutableBool(s.proxy.Root(ResultOperator))
+}
+
+func (s MutableGetRolesResults) Pauser() wasmtypes.ScMutableBool {
+	return wasmtypes.NewScMutableBool(s.proxy.Root(ResultPauser))
+}
+
+type ImmutableGetStatusResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetStatusResults) Status() wasmtypes.ScImmutableUint8 {
+	return wasmtypes.NewScImmutableUint8(s.proxy.Root(ResultStatus))
+}
+
+type MutableGetStatusResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGetStatusResults) Status() wasmtypes.ScMutableUint8 {
+	return wasmtypes.NewScMutableUint8(s.proxy.Root(ResultStatus))
+}
+
+type ImmutableGetSwapResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableGetSwapResults) Nfts() ImmutableNftIDs {
+	return ImmutableNftIDs{proxy: s.proxy.Root(ResultNfts)}
+}
+
+func (s ImmutableGetSwapResults) Swap() ImmutableSwap {
+	return ImmutableSwap{proxy: s.proxy.Root(ResultSwap)}
+}
+
+func (s ImmutableGetSwapResults) Tokens() ImmutableTokenAmounts {
+	return ImmutableTokenAmounts{proxy: s.proxy.Root(ResultTokens)}
+}
+
+type MutableGetSwapResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableGetSwapResults) Nfts() MutableNftIDs {
+	return MutableNftIDs{proxy: s.proxy.Root(ResultNfts)}
+}
+
+func (s MutableGetSwapResults) Swap() MutableSwap {
+	return MutableSwap{proxy: s.proxy.Root(ResultSwap)}
+}
+
+func (s MutableGetSwapResults) Tokens() MutableTokenAmounts {
+	return MutableTokenAmounts{proxy: s.proxy.Root(ResultTokens)}
+}
+
+type ImmutableIsPausedResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s ImmutableIsPausedResults) Paused() wasmtypes.ScImmutableBool {
+	return wasmtypes.NewScImmutableBool(s.proxy.Root(ResultPaused))
+}
+
+type MutableIsPausedResults struct {
+	proxy wasmtypes.Proxy
+}
+
+func (s MutableIsPausedResults) Paused() wasmtypes.ScMutableBool {
+	return wasmtypes.NewScMutableBool(s.proxy.Root(ResultPaused))
 }
@@ -9,32 +9,144 @@ package htlc
 
 import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
 
+type MapHashToImmutableMadSwap struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapHashToImmutableMadSwap) GetMadSwap(key wasmtypes.ScHash) ImmutableMadSwap {
+	return ImmutableMadSwap{proxy: m.proxy.Key(wasmtypes.HashToBytes(key))}
+}
+
+type MapHashToMutableMadSwap struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapHashToMutableMadSwap) Clear() {
+	m.proxy.ClearMap()
+}
+
+func (m MapHashToMutableMadSwap) GetMadSwap(key wasmtypes.ScHash) MutableMadSwap {
+	return MutableMadSwap{proxy: m.proxy.Key(wasmtypes.HashToBytes(key))}
+}
+
+type MapAgentIDToImmutableBool struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapAgentIDToImmutableBool) GetBool(key wasmtypes.ScAgentID) wasmtypes.ScImmutableBool {
+	return wasmtypes.NewScImmutableBool(m.proxy.Key(wasmtypes.AgentIDToBytes(key)))
+}
+
+type MapAgentIDToMutableBool struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapAgentIDToMutableBool) Clear() {
+	m.proxy.ClearMap()
+}
+
+func (m MapAgentIDToMutableBool) GetBool(key wasmtypes.ScAgentID) wasmtypes.ScMutableBool {
+	return wasmtypes.NewScMutableBool(m.proxy.Key(wasmtypes.AgentIDToBytes(key)))
+}
+
+type MapHashToImmutableNftIDs struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapHashToImmutableNftIDs) GetNftIDs(key wasmtypes.ScHash) ImmutableNftIDs {
+	return ImmutableNftIDs{proxy: m.proxy.Key(wasmtypes.HashToBytes(key))}
+}
+
+type MapHashToMutableNftIDs struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapHashToMutableNftIDs) Clear() {
+	m.proxy.ClearMap()
+}
+
+func (m MapHashToMutableNftIDs) GetNftIDs(key wasmtypes.ScHash) MutableNftIDs {
+	return MutableNftIDs{proxy: m.proxy.Key(wasmtypes.HashToBytes(key))}
+}
+
+type MapHashToImmutableTokenAmounts struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapHashToImmutableTokenAmounts) GetTokenAmounts(key wasmtypes.ScHash) ImmutableTokenAmounts {
+	return ImmutableTokenAmounts{proxy: m.proxy.Key(wasmtypes.HashToBytes(key))}
+}
+
+type MapHashToMutableTokenAmounts struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapHashToMutableTokenAmounts) Clear() {
+	m.proxy.ClearMap()
+}
+
+func (m MapHashToMutableTokenAmounts) GetTokenAmounts(key wasmtypes.ScHash) MutableTokenAmounts {
+	return MutableTokenAmounts{proxy: m.proxy.Key(wasmtypes.HashToBytes(key))}
+}
+
+type MapHashToImmutableSwap struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapHashToImmutableSwap) GetSwap(key wasmtypes.ScHash) ImmutableSwap {
+	return ImmutableSwap{proxy: m.proxy.Key(wasmtypes.HashToBytes(key))}
+}
+
+type MapHashToMutableSwap struct {
+	proxy wasmtypes.Proxy
+}
+
+func (m MapHashToMutableSwap) Clear() {
+	m.proxy.ClearMap()
+}
+
+func (m MapHashToMutableSwap) GetSwap(key wasmtypes.ScHash) MutableSwap {
+	return MutableSwap{proxy: m.proxy.Key(wasmtypes.HashToBytes(key))}
+}
+
 type ImmutablehtlcState struct {
 	proxy wasmtypes.Proxy
 }
 
-func (s ImmutablehtlcState) InitTime() wasmtypes.ScImmutableInt64 {
-	return wasmtypes.NewScImmutableInt64(s.proxy.Root(StateInitTime))
+func (s ImmutablehtlcState) MadSwaps() MapHashToImmutableMadSwap {
+	return MapHashToImmutableMadSwap{proxy: s.proxy.Root(StateMadSwaps)}
+}
+
+func (s ImmutablehtlcState) Operators() MapAgentIDToImmutableBool {
+	return MapAgentIDToImmutableBool{proxy: s.proxy.Root(StateOperators)}
 }
 
 func (s ImmutablehtlcState) Owner() wasmtypes.ScImmutableAgentID {
 	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(StateOwner))
 }
 
-func (s ImmutablehtlcState) Receivder() wasmtypes.ScImmutableAddress {
-	return wasmtypes.NewScImmutableAddress(s.proxy.Root(StateReceivder))
+func (s ImmutablehtlcState) Paused() wasmtypes.ScImmutableBool {
+	return wasmtypes.NewScImmutableBool(s.proxy.Root(StatePaused))
+}
+
+func (s ImmutablehtlcState) Pausers() MapAgentIDToImmutableBool {
+	return MapAgentIDToImmutableBool{proxy: s.proxy.Root(StatePausers)}
+}
+
+func (s ImmutablehtlcState) PendingOwner() wasmtypes.ScImmutableAgentID {
+	return wasmtypes.NewScImmutableAgentID(s.proxy.Root(StatePendingOwner))
 }
 
-func (s ImmutablehtlcState) Secret() wasmtypes.ScImmutableHash {
-	return wasmtypes.NewScImmutableHash(s.proxy.Root(StateSecret))
+func (s ImmutablehtlcState) SwapNfts() MapHashToImmutableNftIDs {
+	return MapHashToImmutableNftIDs{proxy: s.proxy.Root(StateSwapNfts)}
 }
 
-func (s ImmutablehtlcState) Time() wasmtypes.ScImmutableInt64 {
-	return wasmtypes.NewScImmutableInt64(s.proxy.Root(StateTime))
+func (s ImmutablehtlcState) SwapTokens() MapHashToImmutableTokenAmounts {
+	return MapHashToImmutableTokenAmounts{proxy: s.proxy.Root(StateSwapTokens)}
 }
 
-func (s ImmutablehtlcState) Value() wasmtypes.ScImmutableUint64 {
-	return wasmtypes.NewScImmutableUint64(s.proxy.Root(StateValue))
+func (s ImmutablehtlcState) Swaps() MapHashToImmutableSwap {
+	return MapHashToImmutableSwap{proxy: s.proxy.Root(StateSwaps)}
 }
 
 type MutablehtlcState struct {
@@ -45,26 +157,38 @@ func (s MutablehtlcState) AsImmutable() ImmutablehtlcState {
 	return ImmutablehtlcState(s)
 }
 
-func (s MutablehtlcState) InitTime() wasmtypes.ScMutableInt64 {
-	return wasmtypes.NewScMutableInt64(s.proxy.Root(StateInitTime))
+func (s MutablehtlcState) MadSwaps() MapHashToMutableMadSwap {
+	return MapHashToMutableMadSwap{proxy: s.proxy.Root(StateMadSwaps)}
+}
+
+func (s MutablehtlcState) Operators() MapAgentIDToMutableBool {
+	return MapAgentIDToMutableBool{proxy: s.proxy.Root(StateOperators)}
 }
 
 func (s MutablehtlcState) Owner() wasmtypes.ScMutableAgentID {
 	return wasmtypes.NewScMutableAgentID(s.proxy.Root(StateOwner))
 }
 
-func (s MutablehtlcState) Receivder() wasmtypes.ScMutableAddress {
-	return wasmtypes.NewScMutableAddress(s.proxy.Root(StateReceivder))
+func (s MutablehtlcState) Paused() wasmtypes.ScMutableBool {
+	return wasmtypes.NewScMutableBool(s.proxy.Root(StatePaused))
+}
+
+func (s MutablehtlcState) Pausers() MapAgentIDToMutableBool {
+	return MapAgentIDToMutableBool{proxy: s.proxy.Root(StatePausers)}
+}
+
+func (s MutablehtlcState) PendingOwner() wasmtypes.ScMutableAgentID {
+	return wasmtypes.NewScMutableAgentID(s.proxy.Root(StatePendingOwner))
 }
 
-func (s MutablehtlcState) Secret() wasmtypes.ScMutableHash {
-	return wasmtypes.NewScMutableHash(s.proxy.Root(StateSecret))
+func (s MutablehtlcState) SwapNfts() MapHashToMutableNftIDs {
+	return MapHashToMutableNftIDs{proxy: s.proxy.Root(StateSwapNfts)}
 }
 
-func (s MutablehtlcState) Time() wasmtypes.ScMutableInt64 {
-	return wasmtypes.NewScMutableInt64(s.proxy.Root(StateTime))
+func (s MutablehtlcState) SwapTokens() MapHashToMutableTokenAmounts {
+	return MapHashToMutableTokenAmounts{proxy: s.proxy.Root(StateSwapTokens)}
 }
 
-func (s MutablehtlcState) Value() wasmtypes.ScMutableUint64 {
-	return wasmtypes.NewScMutableUint64(s.proxy.Root(StateValue))
+func (s MutablehtlcState) Swaps() MapHashToMutableSwap {
+	return MapHashToMutableSwap{proxy: s.proxy.Root(StateSwaps)}
 }
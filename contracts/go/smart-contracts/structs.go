@@ -0,0 +1,238 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// (Re-)generated by schema tool
+// >>>> DO NOT CHANGE THIS FILE! <<<<
+// Change the json schema instead
+
+package htlc
+
+import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
+
+type Swap struct {
+	Sender           wasmtypes.ScAgentID // depositor who created the swap
+	Receivder        wasmtypes.ScAgentID // address, contract or EVM account that can claim
+	Hashlock         wasmtypes.ScHash    // digest of the secret preimage
+	InitTime         int64
+	Time             int64
+	Value            uint64 // iotas escrowed for this swap
+	Preimage         []byte // secret revealed by a successful claim
+	Status           uint8  // Open, Funded, Claimed, Refunded or Cancelled
+	HashAlgo         uint8  // algorithm that maps the preimage onto the hashlock
+	CancelBy         uint8  // parties that agreed to cancel the swap
+	PendingTime      int64  // timelock extension proposed by the sender, awaiting the receiver
+	RelayerFee       uint64 // iotas of the escrow paid to a relayer that submits the claim
+	Collateral       uint64 // iotas the receiver has to post before it can claim
+	CollateralPosted uint64 // iotas the receiver has posted so far
+	BribeDelay       int64  // seconds after initTime from which a claim forfeits the collateral
+	Premium          uint64 // iotas paid to the receiver for the swap option if the swap times out
+}
+
+func NewSwapFromBytes(buf []byte) *Swap {
+	dec := wasmtypes.NewWasmDecoder(buf)
+	data := &Swap{}
+	data.Sender = wasmtypes.AgentIDDecode(dec)
+	data.Receivder = wasmtypes.AgentIDDecode(dec)
+	data.Hashlock = wasmtypes.HashDecode(dec)
+	data.InitTime = wasmtypes.Int64Decode(dec)
+	data.Time = wasmtypes.Int64Decode(dec)
+	data.Value = wasmtypes.Uint64Decode(dec)
+	data.Preimage = wasmtypes.BytesDecode(dec)
+	data.Status = wasmtypes.Uint8Decode(dec)
+	data.HashAlgo = wasmtypes.Uint8Decode(dec)
+	data.CancelBy = wasmtypes.Uint8Decode(dec)
+	data.PendingTime = wasmtypes.Int64Decode(dec)
+	data.RelayerFee = wasmtypes.Uint64Decode(dec)
+	data.Collateral = wasmtypes.Uint64Decode(dec)
+	data.CollateralPosted = wasmtypes.Uint64Decode(dec)
+	data.BribeDelay = wasmtypes.Int64Decode(dec)
+	data.Premium = wasmtypes.Uint64Decode(dec)
+	dec.Close()
+	return data
+}
+
+func (o *Swap) Bytes() []byte {
+	enc := wasmtypes.NewWasmEncoder()
+	wasmtypes.AgentIDEncode(enc, o.Sender)
+	wasmtypes.AgentIDEncode(enc, o.Receivder)
+	wasmtypes.HashEncode(enc, o.Hashlock)
+	wasmtypes.Int64Encode(enc, o.InitTime)
+	wasmtypes.Int64Encode(enc, o.Time)
+	wasmtypes.Uint64Encode(enc, o.Value)
+	wasmtypes.BytesEncode(enc, o.Preimage)
+	wasmtypes.Uint8Encode(enc, o.Status)
+	wasmtypes.Uint8Encode(enc, o.HashAlgo)
+	wasmtypes.Uint8Encode(enc, o.CancelBy)
+	wasmtypes.Int64Encode(enc, o.PendingTime)
+	wasmtypes.Uint64Encode(enc, o.RelayerFee)
+	wasmtypes.Uint64Encode(enc, o.Collateral)
+	wasmtypes.Uint64Encode(enc, o.CollateralPosted)
+	wasmtypes.Int64Encode(enc, o.BribeDelay)
+	wasmtypes.Uint64Encode(enc, o.Premium)
+	return enc.Buf()
+}
+
+type ImmutableSwap struct {
+	proxy wasmtypes.Proxy
+}
+
+func (o ImmutableSwap) Exists() bool {
+	return o.proxy.Exists()
+}
+
+func (o ImmutableSwap) Value() *Swap {
+	return NewSwapFromBytes(o.proxy.Get())
+}
+
+type MutableSwap struct {
+	proxy wasmtypes.Proxy
+}
+
+func (o MutableSwap) Delete() {
+	o.proxy.Delete()
+}
+
+func (o MutableSwap) Exists() bool {
+	return o.proxy.Exists()
+}
+
+func (o MutableSwap) SetValue(value *Swap) {
+	o.proxy.Set(value.Bytes())
+}
+
+func (o MutableSwap) Value() *Swap {
+	return NewSwapFromBytes(o.proxy.Get())
+}
+
+type MadSwap struct {
+	Sender       wasmtypes.ScAgentID // depositor who created the swap
+	Receivder    wasmtypes.ScAgentID // agent that can claim with the preimage
+	Hashlock     wasmtypes.ScHash    // digest of the receiver's claim secret
+	Refundlock   wasmtypes.ScHash    // digest of the sender's refund secret
+	InitTime     int64
+	Time         int64
+	Value        uint64 // iotas deposited by the sender
+	Collateral   uint64 // iotas posted by the sender, lost to a sweep after a censored claim
+	Preimage     []byte // claim secret revealed by a successful claim
+	RefundSecret []byte // refund secret revealed by a successful refund
+	Status       uint8  // Funded, Claimed, Refunded or Swept
+}
+
+func NewMadSwapFromBytes(buf []byte) *MadSwap {
+	dec := wasmtypes.NewWasmDecoder(buf)
+	data := &MadSwap{}
+	data.Sender = wasmtypes.AgentIDDecode(dec)
+	data.Receivder = wasmtypes.AgentIDDecode(dec)
+	data.Hashlock = wasmtypes.HashDecode(dec)
+	data.Refundlock = wasmtypes.HashDecode(dec)
+	data.InitTime = wasmtypes.Int64Decode(dec)
+	data.Time = wasmtypes.Int64Decode(dec)
+	data.Value = wasmtypes.Uint64Decode(dec)
+	data.Collateral = wasmtypes.Uint64Decode(dec)
+	data.Preimage = wasmtypes.BytesDecode(dec)
+	data.RefundSecret = wasmtypes.BytesDecode(dec)
+	data.Status = wasmtypes.Uint8Decode(dec)
+	dec.Close()
+	return data
+}
+
+func (o *MadSwap) Bytes() []byte {
+	enc := wasmtypes.NewWasmEncoder()
+	wasmtypes.AgentIDEncode(enc, o.Sender)
+	wasmtypes.AgentIDEncode(enc, o.Receivder)
+	wasmtypes.HashEncode(enc, o.Hashlock)
+	wasmtypes.HashEncode(enc, o.Refundlock)
+	wasmtypes.Int64Encode(enc, o.InitTime)
+	wasmtypes.Int64Encode(enc, o.Time)
+	wasmtypes.Uint64Encode(enc, o.Value)
+	wasmtypes.Uint64Encode(enc, o.Collateral)
+	wasmtypes.BytesEncode(enc, o.Preimage)
+	wasmtypes.BytesEncode(enc, o.RefundSecret)
+	wasmtypes.Uint8Encode(enc, o.Status)
+	return enc.Buf()
+}
+
+type ImmutableMadSwap struct {
+	proxy wasmtypes.Proxy
+}
+
+func (o ImmutableMadSwap) Exists() bool {
+	return o.proxy.Exists()
+}
+
+func (o ImmutableMadSwap) Value() *MadSwap {
+	return NewMadSwapFromBytes(o.proxy.Get())
+}
+
+type MutableMadSwap struct {
+	proxy wasmtypes.Proxy
+}
+
+func (o MutableMadSwap) Delete() {
+	o.proxy.Delete()
+}
+
+func (o MutableMadSwap) Exists() bool {
+	return o.proxy.Exists()
+}
+
+func (o MutableMadSwap) SetValue(value *MadSwap) {
+	o.proxy.Set(value.Bytes())
+}
+
+func (o MutableMadSwap) Value() *MadSwap {
+	return NewMadSwapFromBytes(o.proxy.Get())
+}
+
+type TokenAmount struct {
+	TokenID wasmtypes.ScTokenID
+	Amount  wasmtypes.ScBigInt
+}
+
+func NewTokenAmountFromBytes(buf []byte) *TokenAmount {
+	dec := wasmtypes.NewWasmDecoder(buf)
+	data := &TokenAmount{}
+	data.TokenID = wasmtypes.TokenIDDecode(dec)
+	data.Amount = wasmtypes.BigIntDecode(dec)
+	dec.Close()
+	return data
+}
+
+func (o *TokenAmount) Bytes() []byte {
+	enc := wasmtypes.NewWasmEncoder()
+	wasmtypes.TokenIDEncode(enc, o.TokenID)
+	wasmtypes.BigIntEncode(enc, o.Amount)
+	return enc.Buf()
+}
+
+type ImmutableTokenAmount struct {
+	proxy wasmtypes.Proxy
+}
+
+func (o ImmutableTokenAmount) Exists() bool {
+	return o.proxy.Exists()
+}
+
+func (o ImmutableTokenAmount) Value() *TokenAmount {
+	return NewTokenAmountFromBytes(o.proxy.Get())
+}
+
+type MutableTokenAmount struct {
+	proxy wasmtypes.Proxy
+}
+
+func (o MutableTokenAmount) Delete() {
+	o.proxy.Delete()
+}
+
+func (o MutableTokenAmount) Exists() bool {
+	return o.proxy.Exists()
+}
+
+func (o MutableTokenAmount) SetValue(value *TokenAmount) {
+	o.proxy.Set(value.Bytes())
+}
+
+func (o MutableTokenAmount) Value() *TokenAmount {
+	return NewTokenAmountFromBytes(o.proxy.Get())
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// (Re-)generated by schema tool
+// >>>> DO NOT CHANGE THIS FILE! <<<<
+// Change the json schema instead
+
+package htlc
+
+import "github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
+
+type ArrayOfImmutableTokenAmount struct {
+	proxy wasmtypes.Proxy
+}
+
+func (a ArrayOfImmutableTokenAmount) Length() uint32 {
+	return a.proxy.Length()
+}
+
+func (a ArrayOfImmutableTokenAmount) GetTokenAmount(index uint32) ImmutableTokenAmount {
+	return ImmutableTokenAmount{proxy: a.proxy.Index(index)}
+}
+
+type ImmutableTokenAmounts = ArrayOfImmutableTokenAmount
+
+type ArrayOfMutableTokenAmount struct {
+	proxy wasmtypes.Proxy
+}
+
+func (a ArrayOfMutableTokenAmount) AppendTokenAmount() MutableTokenAmount {
+	return MutableTokenAmount{proxy: a.proxy.Append()}
+}
+
+func (a ArrayOfMutableTokenAmount) Clear() {
+	a.proxy.ClearArray()
+}
+
+func (a ArrayOfMutableTokenAmount) Length() uint32 {
+	return a.proxy.Length()
+}
+
+func (a ArrayOfMutableTokenAmount) GetTokenAmount(index uint32) MutableTokenAmount {
+	return MutableTokenAmount{proxy: a.proxy.Index(index)}
+}
+
+type MutableTokenAmounts = ArrayOfMutableTokenAmount
+
+type ArrayOfImmutableNftID struct {
+	proxy wasmtypes.Proxy
+}
+
+func (a ArrayOfImmutableNftID) Length() uint32 {
+	return a.proxy.Length()
+}
+
+func (a ArrayOfImmutableNftID) GetNftID(index uint32) wasmtypes.ScImmutableNftID {
+	return wasmtypes.NewScImmutableNftID(a.proxy.Index(index))
+}
+
+type ImmutableNftIDs = ArrayOfImmutableNftID
+
+type ArrayOfMutableNftID struct {
+	proxy wasmtypes.Proxy
+}
+
+func (a ArrayOfMutableNftID) AppendNftID() wasmtypes.ScMutableNftID {
+	return wasmtypes.NewScMutableNftID(a.proxy.Append())
+}
+
+func (a ArrayOfMutableNftID) Clear() {
+	a.proxy.ClearArray()
+}
+
+func (a ArrayOfMutableNftID) Length() uint32 {
+	return a.proxy.Length()
+}
+
+func (a ArrayOfMutableNftID) GetNftID(index uint32) wasmtypes.ScMutableNftID {
+	return wasmtypes.NewScMutableNftID(a.proxy.Index(index))
+}
+
+type MutableNftIDs = ArrayOfMutableNftID
@@ -4,14 +4,863 @@
 package test
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/iotaledger/wasp/smart-contracts/go/htlc"
+	"github.com/iotaledger/wasp/smart-contracts/go/ptlc"
+	"github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib"
+	"github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
 	"github.com/iotaledger/wasp/packages/wasmvm/wasmsolo"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
 )
 
+var preimage = []byte("abbbc")
+
+func hashlock(preimage []byte) wasmtypes.ScHash {
+	digest := blake2b.Sum256(preimage)
+	return wasmtypes.HashFromBytes(digest[:])
+}
+
+// newOperator returns a new agent holding the operator role, so that it is
+// allowed to create swaps
+func newOperator(t *testing.T, ctx *wasmsolo.SoloContext) *wasmsolo.SoloAgent {
+	agent := ctx.NewSoloAgent()
+	f := htlc.ScFuncs.GrantRole(ctx)
+	f.Params.Role().SetValue(htlc.RoleOperator)
+	f.Params.AgentID().SetValue(agent.ScAgentID())
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	return agent
+}
+
+func newSwap(t *testing.T, ctx *wasmsolo.SoloContext, sender, receiver *wasmsolo.SoloAgent, lock int64, amount uint64) wasmtypes.ScHash {
+	f := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(hashlock(preimage))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(lock)
+	f.Func.AllowanceIotas(amount).Post()
+	require.NoError(t, ctx.Err)
+	return f.Results.SwapID().Value()
+}
+
+func getSwap(t *testing.T, ctx *wasmsolo.SoloContext, id wasmtypes.ScHash) *htlc.Swap {
+	v := htlc.ScFuncs.GetSwap(ctx)
+	v.Params.SwapID().SetValue(id)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	return v.Results.Swap().Value()
+}
+
+func getStatus(t *testing.T, ctx *wasmsolo.SoloContext, id wasmtypes.ScHash) uint8 {
+	v := htlc.ScFuncs.GetStatus(ctx)
+	v.Params.SwapID().SetValue(id)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	return v.Results.Status().Value()
+}
+
 func TestDeploy(t *testing.T) {
 	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
 	require.NoError(t, ctx.ContractExists(htlc.ScName))
 }
+
+func getOwner(t *testing.T, ctx *wasmsolo.SoloContext) *htlc.ImmutableGetOwnerResults {
+	v := htlc.ScFuncs.GetOwner(ctx)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	return &v.Results
+}
+
+func getRoles(t *testing.T, ctx *wasmsolo.SoloContext, agent wasmtypes.ScAgentID) *htlc.ImmutableGetRolesResults {
+	v := htlc.ScFuncs.GetRoles(ctx)
+	v.Params.AgentID().SetValue(agent)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	return &v.Results
+}
+
+func TestOwnership(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	owner := getOwner(t, ctx).Owner().Value()
+	newOwner := ctx.NewSoloAgent()
+	stranger := ctx.NewSoloAgent()
+
+	p := htlc.ScFuncs.ProposeOwner(ctx.Sign(stranger))
+	p.Params.Owner().SetValue(stranger.ScAgentID())
+	p.Func.Post()
+	require.Error(t, ctx.Err)
+
+	// a proposal can be withdrawn before it is accepted
+	p = htlc.ScFuncs.ProposeOwner(ctx)
+	p.Params.Owner().SetValue(stranger.ScAgentID())
+	p.Func.Post()
+	require.NoError(t, ctx.Err)
+	htlc.ScFuncs.CancelOwnership(ctx).Func.Post()
+	require.NoError(t, ctx.Err)
+	require.False(t, getOwner(t, ctx).PendingOwner().Exists())
+
+	p = htlc.ScFuncs.ProposeOwner(ctx)
+	p.Params.Owner().SetValue(newOwner.ScAgentID())
+	p.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, owner, getOwner(t, ctx).Owner().Value())
+	require.Equal(t, newOwner.ScAgentID(), getOwner(t, ctx).PendingOwner().Value())
+
+	htlc.ScFuncs.AcceptOwnership(ctx.Sign(stranger)).Func.Post()
+	require.Error(t, ctx.Err)
+
+	htlc.ScFuncs.AcceptOwnership(ctx.Sign(newOwner)).Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, newOwner.ScAgentID(), getOwner(t, ctx).Owner().Value())
+	require.False(t, getOwner(t, ctx).PendingOwner().Exists())
+
+	// the roles of the old owner moved to the new owner
+	roles := getRoles(t, ctx, newOwner.ScAgentID())
+	require.True(t, roles.Admin().Value())
+	require.True(t, roles.Operator().Value())
+	require.True(t, roles.Pauser().Value())
+	roles = getRoles(t, ctx, owner)
+	require.False(t, roles.Admin().Value())
+	require.False(t, roles.Operator().Value())
+	require.False(t, roles.Pauser().Value())
+
+	htlc.ScFuncs.Pause(ctx).Func.Post()
+	require.Error(t, ctx.Err)
+	htlc.ScFuncs.Pause(ctx.Sign(newOwner)).Func.Post()
+	require.NoError(t, ctx.Err)
+}
+
+func TestRoles(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	operator := ctx.NewSoloAgent()
+	stranger := ctx.NewSoloAgent()
+	receiver := ctx.NewSoloAgent()
+
+	// only the admin can grant roles
+	g := htlc.ScFuncs.GrantRole(ctx.Sign(operator))
+	g.Params.Role().SetValue(htlc.RoleOperator)
+	g.Params.AgentID().SetValue(operator.ScAgentID())
+	g.Func.Post()
+	require.Error(t, ctx.Err)
+
+	g = htlc.ScFuncs.GrantRole(ctx)
+	g.Params.Role().SetValue(htlc.RoleOperator)
+	g.Params.AgentID().SetValue(operator.ScAgentID())
+	g.Func.Post()
+	require.NoError(t, ctx.Err)
+
+	// an agent can only be granted a role once
+	g.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "agent already holds the role")
+
+	roles := getRoles(t, ctx, operator.ScAgentID())
+	require.False(t, roles.Admin().Value())
+	require.True(t, roles.Operator().Value())
+	require.False(t, roles.Pauser().Value())
+
+	// the owner keeps the role next to the new member
+	roles = getRoles(t, ctx, getOwner(t, ctx).Owner().Value())
+	require.True(t, roles.Admin().Value())
+	require.True(t, roles.Operator().Value())
+	require.True(t, roles.Pauser().Value())
+
+	f := htlc.ScFuncs.NewSwap(ctx.Sign(stranger))
+	f.Params.Hashlock().SetValue(hashlock(preimage))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.AllowanceIotas(1000).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "no permission")
+
+	newSwap(t, ctx, operator, receiver, 60, 1000)
+
+	// a second operator does not replace the first one
+	second := newOperator(t, ctx)
+	f = htlc.ScFuncs.NewSwap(ctx.Sign(second))
+	f.Params.Hashlock().SetValue(hashlock([]byte("second")))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.AllowanceIotas(1000).Post()
+	require.NoError(t, ctx.Err)
+
+	r := htlc.ScFuncs.RevokeRole(ctx)
+	r.Params.Role().SetValue(htlc.RoleOperator)
+	r.Params.AgentID().SetValue(operator.ScAgentID())
+	r.Func.Post()
+	require.NoError(t, ctx.Err)
+
+	r.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "agent does not hold the role")
+
+	f = htlc.ScFuncs.NewSwap(ctx.Sign(operator))
+	f.Params.Hashlock().SetValue(hashlock([]byte("other")))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.AllowanceIotas(1000).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "no permission")
+
+	f = htlc.ScFuncs.NewSwap(ctx.Sign(second))
+	f.Params.Hashlock().SetValue(hashlock([]byte("other")))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.AllowanceIotas(1000).Post()
+	require.NoError(t, ctx.Err)
+}
+
+func TestPause(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+	n := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	n.Params.Hashlock().SetValue(hashlock([]byte("collateral")))
+	n.Params.Receivder().SetValue(receiver.ScAgentID())
+	n.Params.Collateral().SetValue(500)
+	n.Params.Time().SetValue(60)
+	n.Func.AllowanceIotas(1000).Post()
+	require.NoError(t, ctx.Err)
+	collateralID := n.Results.SwapID().Value()
+	madID := madNewSwap(t, ctx, sender, receiver, 60, 1000)
+
+	htlc.ScFuncs.Pause(ctx.Sign(sender)).Func.Post()
+	require.Error(t, ctx.Err)
+	htlc.ScFuncs.Pause(ctx).Func.Post()
+	require.NoError(t, ctx.Err)
+	v := htlc.ScFuncs.IsPaused(ctx)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	require.True(t, v.Results.Paused().Value())
+
+	f := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(hashlock([]byte("other")))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.AllowanceIotas(1000).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrPaused)
+
+	// the receiver can still post its collateral and claim while paused
+	p := htlc.ScFuncs.PostCollateral(ctx.Sign(receiver))
+	p.Params.SwapID().SetValue(collateralID)
+	p.Func.AllowanceIotas(500).Post()
+	require.NoError(t, ctx.Err)
+	balance := receiver.Balance()
+	c := htlc.ScFuncs.Claim(ctx)
+	c.Params.SwapID().SetValue(collateralID)
+	c.Params.Preimage().SetValue([]byte("collateral"))
+	c.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, balance+1500, receiver.Balance())
+	require.Equal(t, htlc.StatusClaimed, getStatus(t, ctx, collateralID))
+
+	// but no new collateral can be put at stake in MAD-HTLC swaps
+	m := htlc.ScFuncs.MadCollateral(ctx.Sign(sender))
+	m.Params.SwapID().SetValue(madID)
+	m.Func.AllowanceIotas(500).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrPaused)
+
+	// expired swaps can still be refunded while paused
+	ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+	r := htlc.ScFuncs.Refund(ctx)
+	r.Params.SwapID().SetValue(id)
+	r.Func.Post()
+	require.NoError(t, ctx.Err)
+
+	htlc.ScFuncs.Unpause(ctx).Func.Post()
+	require.NoError(t, ctx.Err)
+	newSwap(t, ctx, sender, receiver, 60, 1000)
+}
+
+func TestNewSwap(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+
+	swap := getSwap(t, ctx, id)
+	require.Equal(t, sender.ScAgentID(), swap.Sender)
+	require.Equal(t, receiver.ScAgentID(), swap.Receivder)
+	require.EqualValues(t, 1000, swap.Value)
+	require.Equal(t, htlc.StatusFunded, swap.Status)
+}
+
+func TestNewSwapIncomplete(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	f := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(hashlock(preimage))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Func.AllowanceIotas(1000).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "missing mandatory time")
+
+	f = htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(hashlock(preimage))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "missing allowance")
+}
+
+func TestNewSwapTimeOverflow(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	// a deadline past MaxInt64 would wrap around and expire the swap at once
+	for _, lock := range []int64{-1, math.MaxInt64} {
+		f := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+		f.Params.Hashlock().SetValue(hashlock(preimage))
+		f.Params.Receivder().SetValue(receiver.ScAgentID())
+		f.Params.Time().SetValue(lock)
+		f.Func.AllowanceIotas(1000).Post()
+		require.Error(t, ctx.Err)
+		require.Contains(t, ctx.Err.Error(), "invalid time")
+	}
+}
+
+func TestConcurrentSwaps(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver1 := ctx.NewSoloAgent()
+	receiver2 := ctx.NewSoloAgent()
+
+	id1 := newSwap(t, ctx, sender, receiver1, 60, 1000)
+	id2 := newSwap(t, ctx, sender, receiver2, 60, 2000)
+	require.NotEqual(t, id1, id2)
+	require.EqualValues(t, 1000, getSwap(t, ctx, id1).Value)
+	require.EqualValues(t, 2000, getSwap(t, ctx, id2).Value)
+
+	f := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(hashlock(preimage))
+	f.Params.Receivder().SetValue(receiver1.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.AllowanceIotas(1000).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "swap already exists, its terms are frozen")
+}
+
+func TestTermsFrozen(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 3600, 1000)
+
+	// the sender cannot shorten the timelock of a funded swap
+	e := htlc.ScFuncs.ExtendTimelock(ctx.Sign(sender))
+	e.Params.SwapID().SetValue(id)
+	e.Params.Time().SetValue(1)
+	e.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "timelock can only be extended")
+
+	// nor approve its own extension
+	e = htlc.ScFuncs.ExtendTimelock(ctx.Sign(sender))
+	e.Params.SwapID().SetValue(id)
+	e.Params.Time().SetValue(7200)
+	e.Func.Post()
+	require.NoError(t, ctx.Err)
+	a := htlc.ScFuncs.ApproveExtension(ctx.Sign(sender))
+	a.Params.SwapID().SetValue(id)
+	a.Params.Time().SetValue(7200)
+	a.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "only receivder can approve an extension")
+
+	// nor call off the swap on its own
+	c := htlc.ScFuncs.Cancel(ctx.Sign(sender))
+	c.Params.SwapID().SetValue(id)
+	c.Func.Post()
+	require.NoError(t, ctx.Err)
+
+	swap := getSwap(t, ctx, id)
+	require.Equal(t, htlc.StatusFunded, swap.Status)
+	require.Equal(t, receiver.ScAgentID(), swap.Receivder)
+	require.Equal(t, hashlock(preimage), swap.Hashlock)
+	require.EqualValues(t, 3600, swap.Time)
+	require.EqualValues(t, 1000, swap.Value)
+}
+
+func TestClaim(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+	balance := receiver.Balance()
+
+	// anyone who knows the preimage can submit the claim for the receiver
+	f := htlc.ScFuncs.Claim(ctx)
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, receiver.ScAgentID(), f.Results.Recipient().Value())
+	require.EqualValues(t, 1000, f.Results.Value().Value())
+
+	require.EqualValues(t, balance+1000, receiver.Balance())
+	require.EqualValues(t, 0, getSwap(t, ctx, id).Value)
+
+	v := htlc.ScFuncs.GetPreimage(ctx)
+	v.Params.SwapID().SetValue(id)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, preimage, v.Results.Preimage().Value())
+}
+
+func TestRelayedClaim(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+	relayer := ctx.NewSoloAgent()
+
+	n := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	n.Params.Hashlock().SetValue(hashlock(preimage))
+	n.Params.Receivder().SetValue(receiver.ScAgentID())
+	n.Params.RelayerFee().SetValue(100)
+	n.Params.Time().SetValue(60)
+	n.Func.AllowanceIotas(1000).Post()
+	require.NoError(t, ctx.Err)
+	id := n.Results.SwapID().Value()
+	balance := receiver.Balance()
+
+	f := htlc.ScFuncs.Claim(ctx.Sign(relayer))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, receiver.ScAgentID(), f.Results.Recipient().Value())
+	require.EqualValues(t, 900, f.Results.Value().Value())
+	require.EqualValues(t, 100, f.Results.Fee().Value())
+	require.EqualValues(t, balance+900, receiver.Balance())
+}
+
+func TestClaimRedirect(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+	other := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+
+	// nobody but the receiver can redirect the escrow without its signature
+	f := htlc.ScFuncs.Claim(ctx.Sign(other))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Params.Recipient().SetValue(other.ScAgentID())
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	balance := other.Balance()
+
+	f = htlc.ScFuncs.Claim(ctx.Sign(receiver))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Params.Recipient().SetValue(other.ScAgentID())
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, other.ScAgentID(), f.Results.Recipient().Value())
+	require.EqualValues(t, balance+1000, other.Balance())
+}
+
+func TestCollateral(t *testing.T) {
+	for _, late := range []bool{false, true} {
+		ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+		sender := newOperator(t, ctx)
+		receiver := ctx.NewSoloAgent()
+
+		n := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+		n.Params.Hashlock().SetValue(hashlock(preimage))
+		n.Params.Receivder().SetValue(receiver.ScAgentID())
+		n.Params.Collateral().SetValue(500)
+		n.Params.BribeDelay().SetValue(30)
+		n.Params.Time().SetValue(60)
+		n.Func.AllowanceIotas(1000).Post()
+		require.NoError(t, ctx.Err)
+		id := n.Results.SwapID().Value()
+
+		c := htlc.ScFuncs.Claim(ctx)
+		c.Params.SwapID().SetValue(id)
+		c.Params.Preimage().SetValue(preimage)
+		c.Func.Post()
+		require.Error(t, ctx.Err)
+		require.Contains(t, ctx.Err.Error(), "collateral not posted")
+
+		p := htlc.ScFuncs.PostCollateral(ctx.Sign(receiver))
+		p.Params.SwapID().SetValue(id)
+		p.Func.AllowanceIotas(500).Post()
+		require.NoError(t, ctx.Err)
+		require.EqualValues(t, 500, getSwap(t, ctx, id).CollateralPosted)
+		senderBalance := sender.Balance()
+		receiverBalance := receiver.Balance()
+
+		// the receiver chose the secret, a claim it holds back past the bribe
+		// delay forfeits the collateral to the sender
+		if late {
+			ctx.Chain.Env.AdvanceClockBy(45 * time.Second)
+		}
+		c = htlc.ScFuncs.Claim(ctx)
+		c.Params.SwapID().SetValue(id)
+		c.Params.Preimage().SetValue(preimage)
+		c.Func.Post()
+		require.NoError(t, ctx.Err)
+		if late {
+			require.EqualValues(t, senderBalance+500, sender.Balance())
+			require.EqualValues(t, receiverBalance+1000, receiver.Balance())
+		} else {
+			require.EqualValues(t, senderBalance, sender.Balance())
+			require.EqualValues(t, receiverBalance+1500, receiver.Balance())
+		}
+		require.EqualValues(t, 0, getSwap(t, ctx, id).CollateralPosted)
+	}
+}
+
+func TestPremium(t *testing.T) {
+	for _, timeout := range []bool{false, true} {
+		ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+		sender := newOperator(t, ctx)
+		receiver := ctx.NewSoloAgent()
+
+		n := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+		n.Params.Hashlock().SetValue(hashlock(preimage))
+		n.Params.Receivder().SetValue(receiver.ScAgentID())
+		n.Params.Premium().SetValue(100)
+		n.Params.Time().SetValue(60)
+		n.Func.AllowanceIotas(1100).Post()
+		require.NoError(t, ctx.Err)
+		id := n.Results.SwapID().Value()
+		swap := getSwap(t, ctx, id)
+		require.EqualValues(t, 1000, swap.Value)
+		require.EqualValues(t, 100, swap.Premium)
+		senderBalance := sender.Balance()
+		receiverBalance := receiver.Balance()
+
+		// the premium compensates the receiver only when the swap times out
+		if timeout {
+			ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+			f := htlc.ScFuncs.Refund(ctx)
+			f.Params.SwapID().SetValue(id)
+			f.Func.Post()
+			require.NoError(t, ctx.Err)
+			require.EqualValues(t, 100, f.Results.Premium().Value())
+			require.EqualValues(t, senderBalance+1000, sender.Balance())
+			require.EqualValues(t, receiverBalance+100, receiver.Balance())
+		} else {
+			f := htlc.ScFuncs.Claim(ctx)
+			f.Params.SwapID().SetValue(id)
+			f.Params.Preimage().SetValue(preimage)
+			f.Func.Post()
+			require.NoError(t, ctx.Err)
+			require.EqualValues(t, 100, f.Results.Premium().Value())
+			require.EqualValues(t, senderBalance+100, sender.Balance())
+			require.EqualValues(t, receiverBalance+1000, receiver.Balance())
+		}
+	}
+}
+
+func TestTokenEscrow(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	foundry, err := ctx.NewSoloFoundry(1000, sender)
+	require.NoError(t, err)
+	require.NoError(t, foundry.Mint(1000))
+	tokenID := foundry.TokenID()
+
+	allowance := wasmlib.NewScTransferIotas(1000)
+	allowance.Set(&tokenID, wasmtypes.NewScBigInt(400))
+	f := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(hashlock(preimage))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.Allowance(allowance).Post()
+	require.NoError(t, ctx.Err)
+	id := f.Results.SwapID().Value()
+
+	v := htlc.ScFuncs.GetSwap(ctx)
+	v.Params.SwapID().SetValue(id)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	tokens := v.Results.Tokens()
+	require.EqualValues(t, 1, tokens.Length())
+	token := tokens.GetTokenAmount(0).Value()
+	require.Equal(t, tokenID, token.TokenID)
+	require.EqualValues(t, 400, token.Amount.Uint64())
+
+	c := htlc.ScFuncs.Claim(ctx)
+	c.Params.SwapID().SetValue(id)
+	c.Params.Preimage().SetValue(preimage)
+	c.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, 400, receiver.Balance(tokenID))
+}
+
+func TestPreimageNotRevealed(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+
+	v := htlc.ScFuncs.GetPreimage(ctx)
+	v.Params.SwapID().SetValue(id)
+	v.Func.Call()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "preimage not revealed")
+}
+
+func TestRefund(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+	watchtower := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+	ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+	balance := sender.Balance()
+
+	// anyone can trigger the refund, but it is always paid to the sender
+	f := htlc.ScFuncs.Refund(ctx.Sign(watchtower))
+	f.Params.SwapID().SetValue(id)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, sender.ScAgentID(), f.Results.Recipient().Value())
+	require.EqualValues(t, 1000, f.Results.Value().Value())
+
+	require.EqualValues(t, balance+1000, sender.Balance())
+	require.EqualValues(t, 0, getSwap(t, ctx, id).Value)
+}
+
+func TestCancel(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+	stranger := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+
+	f := htlc.ScFuncs.Cancel(ctx.Sign(stranger))
+	f.Params.SwapID().SetValue(id)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+
+	// the sender proposes, the swap stays funded until the receiver confirms
+	f = htlc.ScFuncs.Cancel(ctx.Sign(sender))
+	f.Params.SwapID().SetValue(id)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, htlc.StatusFunded, getStatus(t, ctx, id))
+	balance := sender.Balance()
+
+	f = htlc.ScFuncs.Cancel(ctx.Sign(receiver))
+	f.Params.SwapID().SetValue(id)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, htlc.StatusCancelled, getStatus(t, ctx, id))
+	require.EqualValues(t, balance+1000, sender.Balance())
+
+	c := htlc.ScFuncs.Claim(ctx)
+	c.Params.SwapID().SetValue(id)
+	c.Params.Preimage().SetValue(preimage)
+	c.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrAlreadySettled)
+}
+
+func TestExtendTimelock(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+
+	f := htlc.ScFuncs.ExtendTimelock(ctx.Sign(sender))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Time().SetValue(30)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+
+	// an extension whose deadline wraps around would let the sender refund
+	f = htlc.ScFuncs.ExtendTimelock(ctx.Sign(sender))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Time().SetValue(math.MaxInt64)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "invalid time")
+
+	f = htlc.ScFuncs.ExtendTimelock(ctx.Sign(sender))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Time().SetValue(180)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, 60, getSwap(t, ctx, id).Time)
+
+	a := htlc.ScFuncs.ApproveExtension(ctx.Sign(receiver))
+	a.Params.SwapID().SetValue(id)
+	a.Params.Time().SetValue(120)
+	a.Func.Post()
+	require.Error(t, ctx.Err)
+
+	a = htlc.ScFuncs.ApproveExtension(ctx.Sign(receiver))
+	a.Params.SwapID().SetValue(id)
+	a.Params.Time().SetValue(180)
+	a.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, 180, getSwap(t, ctx, id).Time)
+
+	// the original timelock has passed, the extended one has not
+	ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+	c := htlc.ScFuncs.Claim(ctx)
+	c.Params.SwapID().SetValue(id)
+	c.Params.Preimage().SetValue(preimage)
+	c.Func.Post()
+	require.NoError(t, ctx.Err)
+}
+
+func TestSettlementErrors(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+
+	f := htlc.ScFuncs.Claim(ctx)
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue([]byte("wrong"))
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrWrongPreimage)
+
+	r := htlc.ScFuncs.Refund(ctx.Sign(sender))
+	r.Params.SwapID().SetValue(id)
+	r.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrNotExpired)
+
+	ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+	f = htlc.ScFuncs.Claim(ctx)
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrExpired)
+	require.Equal(t, htlc.StatusFunded, getStatus(t, ctx, id))
+}
+
+func TestSettleOnce(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := newSwap(t, ctx, sender, receiver, 60, 1000)
+	require.Equal(t, htlc.StatusFunded, getStatus(t, ctx, id))
+
+	f := htlc.ScFuncs.Claim(ctx)
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, htlc.StatusClaimed, getStatus(t, ctx, id))
+
+	f = htlc.ScFuncs.Claim(ctx)
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrAlreadySettled)
+
+	ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+	r := htlc.ScFuncs.Refund(ctx.Sign(sender))
+	r.Params.SwapID().SetValue(id)
+	r.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrAlreadySettled)
+}
+
+func TestHashAlgorithms(t *testing.T) {
+	sha := sha256.Sum256(preimage)
+	keccak := sha3.NewLegacyKeccak256()
+	keccak.Write(preimage)
+	hashlocks := map[uint8]wasmtypes.ScHash{
+		htlc.HashBlake2b:   hashlock(preimage),
+		htlc.HashSha256:    wasmtypes.HashFromBytes(sha[:]),
+		htlc.HashKeccak256: wasmtypes.HashFromBytes(keccak.Sum(nil)),
+	}
+	// same hash as the one locking the EVM contract in HTCL.sol
+	evm, err := hex.DecodeString("529bd57c54687cfd2bf23415e7b13d342f623c24a21387d669b7c6537020007d")
+	require.NoError(t, err)
+	require.Equal(t, evm, hashlocks[htlc.HashKeccak256].Bytes())
+
+	for hashAlgo, lock := range hashlocks {
+		ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+		sender := newOperator(t, ctx)
+		receiver := ctx.NewSoloAgent()
+
+		f := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+		f.Params.Hashlock().SetValue(lock)
+		f.Params.HashAlgo().SetValue(hashAlgo)
+		f.Params.Receivder().SetValue(receiver.ScAgentID())
+		f.Params.Time().SetValue(60)
+		f.Func.AllowanceIotas(1000).Post()
+		require.NoError(t, ctx.Err)
+		id := f.Results.SwapID().Value()
+
+		c := htlc.ScFuncs.Claim(ctx)
+		c.Params.SwapID().SetValue(id)
+		c.Params.Preimage().SetValue(preimage)
+		c.Func.Post()
+		require.NoError(t, ctx.Err)
+		require.Equal(t, htlc.StatusClaimed, getStatus(t, ctx, id))
+	}
+}
+
+func TestPointLock(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	secret, point, err := ptlc.NewSecret(rand.Reader)
+	require.NoError(t, err)
+
+	f := htlc.ScFuncs.NewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(wasmtypes.HashFromBytes(point))
+	f.Params.HashAlgo().SetValue(htlc.HashEd25519Point)
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(60)
+	f.Func.AllowanceIotas(1000).Post()
+	require.NoError(t, ctx.Err)
+	id := f.Results.SwapID().Value()
+
+	wrong, _, err := ptlc.NewSecret(rand.Reader)
+	require.NoError(t, err)
+	c := htlc.ScFuncs.Claim(ctx)
+	c.Params.SwapID().SetValue(id)
+	c.Params.Preimage().SetValue(wrong)
+	c.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrWrongPreimage)
+
+	c = htlc.ScFuncs.Claim(ctx)
+	c.Params.SwapID().SetValue(id)
+	c.Params.Preimage().SetValue(secret)
+	c.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.Equal(t, htlc.StatusClaimed, getStatus(t, ctx, id))
+}
@@ -0,0 +1,227 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/wasp/smart-contracts/go/htlc"
+	"github.com/iotaledger/wasp/packages/wasmvm/wasmlib/go/wasmlib/wasmtypes"
+	"github.com/iotaledger/wasp/packages/wasmvm/wasmsolo"
+	"github.com/stretchr/testify/require"
+)
+
+var refundSecret = []byte("refund")
+
+func madNewSwap(t *testing.T, ctx *wasmsolo.SoloContext, sender, receiver *wasmsolo.SoloAgent, lock int64, amount uint64) wasmtypes.ScHash {
+	f := htlc.ScFuncs.MadNewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(hashlock(preimage))
+	f.Params.Refundlock().SetValue(hashlock(refundSecret))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(lock)
+	f.Func.AllowanceIotas(amount).Post()
+	require.NoError(t, ctx.Err)
+	return f.Results.SwapID().Value()
+}
+
+func madCollateral(t *testing.T, ctx *wasmsolo.SoloContext, sender *wasmsolo.SoloAgent, id wasmtypes.ScHash, amount uint64) {
+	f := htlc.ScFuncs.MadCollateral(ctx.Sign(sender))
+	f.Params.SwapID().SetValue(id)
+	f.Func.AllowanceIotas(amount).Post()
+	require.NoError(t, ctx.Err)
+}
+
+func getMadSwap(t *testing.T, ctx *wasmsolo.SoloContext, id wasmtypes.ScHash) *htlc.MadSwap {
+	v := htlc.ScFuncs.GetMadSwap(ctx)
+	v.Params.SwapID().SetValue(id)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	return v.Results.Swap().Value()
+}
+
+func TestMadClaim(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := madNewSwap(t, ctx, sender, receiver, 60, 1000)
+
+	// only the sender puts collateral at stake
+	m := htlc.ScFuncs.MadCollateral(ctx.Sign(receiver))
+	m.Params.SwapID().SetValue(id)
+	m.Func.AllowanceIotas(500).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "only sender can post collateral")
+
+	madCollateral(t, ctx, sender, id, 500)
+	require.EqualValues(t, 500, getMadSwap(t, ctx, id).Collateral)
+	balance := receiver.Balance()
+	senderBalance := sender.Balance()
+
+	f := htlc.ScFuncs.MadClaim(ctx)
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, balance+1000, receiver.Balance())
+
+	// the collateral stays at stake until the timelock has passed
+	r := htlc.ScFuncs.MadReleaseCollateral(ctx)
+	r.Params.SwapID().SetValue(id)
+	r.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrNotExpired)
+
+	ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+	r = htlc.ScFuncs.MadReleaseCollateral(ctx)
+	r.Params.SwapID().SetValue(id)
+	r.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, balance+1000, receiver.Balance())
+	require.EqualValues(t, senderBalance+500, sender.Balance())
+}
+
+func TestMadNewSwapTimeOverflow(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	f := htlc.ScFuncs.MadNewSwap(ctx.Sign(sender))
+	f.Params.Hashlock().SetValue(hashlock(preimage))
+	f.Params.Refundlock().SetValue(hashlock(refundSecret))
+	f.Params.Receivder().SetValue(receiver.ScAgentID())
+	f.Params.Time().SetValue(math.MaxInt64)
+	f.Func.AllowanceIotas(1000).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "invalid time")
+}
+
+func TestMadRefund(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := madNewSwap(t, ctx, sender, receiver, 60, 1000)
+	ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+	balance := sender.Balance()
+
+	f := htlc.ScFuncs.MadRefund(ctx)
+	f.Params.SwapID().SetValue(id)
+	f.Params.RefundSecret().SetValue(preimage)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrWrongRefundSecret)
+
+	f = htlc.ScFuncs.MadRefund(ctx)
+	f.Params.SwapID().SetValue(id)
+	f.Params.RefundSecret().SetValue(refundSecret)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, balance+1000, sender.Balance())
+	require.Equal(t, htlc.StatusRefunded, getMadSwap(t, ctx, id).Status)
+}
+
+func TestMadSweep(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+	validator := ctx.NewSoloAgent()
+
+	id := madNewSwap(t, ctx, sender, receiver, 60, 1000)
+	madCollateral(t, ctx, sender, id, 500)
+
+	// the claim secret alone is not enough to sweep
+	f := htlc.ScFuncs.MadSweep(ctx.Sign(validator))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Params.RefundSecret().SetValue(preimage)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrWrongRefundSecret)
+
+	// once both secrets are out, whoever sweeps first takes everything
+	f = htlc.ScFuncs.MadSweep(ctx.Sign(validator))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Params.RefundSecret().SetValue(refundSecret)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, 1500, f.Results.Value().Value())
+
+	swap := getMadSwap(t, ctx, id)
+	require.Equal(t, htlc.StatusSwept, swap.Status)
+	require.EqualValues(t, 0, swap.Value)
+	require.EqualValues(t, 0, swap.Collateral)
+
+	c := htlc.ScFuncs.MadClaim(ctx)
+	c.Params.SwapID().SetValue(id)
+	c.Params.Preimage().SetValue(preimage)
+	c.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrAlreadySettled)
+}
+
+func TestMadSweepAfterClaim(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := madNewSwap(t, ctx, sender, receiver, 60, 1000)
+	madCollateral(t, ctx, sender, id, 500)
+
+	c := htlc.ScFuncs.MadClaim(ctx.Sign(receiver))
+	c.Params.SwapID().SetValue(id)
+	c.Params.Preimage().SetValue(preimage)
+	c.Func.Post()
+	require.NoError(t, ctx.Err)
+
+	// the claim published the preimage, but a claimed swap cannot be swept,
+	// not even by the sender who knows the refund secret
+	f := htlc.ScFuncs.MadSweep(ctx.Sign(sender))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Params.RefundSecret().SetValue(refundSecret)
+	f.Func.Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), htlc.ErrAlreadySettled)
+
+	swap := getMadSwap(t, ctx, id)
+	require.Equal(t, htlc.StatusClaimed, swap.Status)
+	require.EqualValues(t, 500, swap.Collateral)
+}
+
+func TestMadSweepAfterRefund(t *testing.T) {
+	ctx := wasmsolo.NewSoloContext(t, htlc.ScName, htlc.OnLoad)
+	sender := newOperator(t, ctx)
+	receiver := ctx.NewSoloAgent()
+
+	id := madNewSwap(t, ctx, sender, receiver, 60, 1000)
+	madCollateral(t, ctx, sender, id, 500)
+
+	// the receiver's claim was censored, the sender refunds after the timelock
+	ctx.Chain.Env.AdvanceClockBy(2 * time.Minute)
+	r := htlc.ScFuncs.MadRefund(ctx.Sign(sender))
+	r.Params.SwapID().SetValue(id)
+	r.Params.RefundSecret().SetValue(refundSecret)
+	r.Func.Post()
+	require.NoError(t, ctx.Err)
+	balance := receiver.Balance()
+
+	// the refund revealed the refund secret, the receiver takes the collateral
+	f := htlc.ScFuncs.MadSweep(ctx.Sign(receiver))
+	f.Params.SwapID().SetValue(id)
+	f.Params.Preimage().SetValue(preimage)
+	f.Params.RefundSecret().SetValue(refundSecret)
+	f.Func.Post()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, 500, f.Results.Value().Value())
+	require.EqualValues(t, balance+500, receiver.Balance())
+
+	rc := htlc.ScFuncs.MadReleaseCollateral(ctx)
+	rc.Params.SwapID().SetValue(id)
+	rc.Func.Post()
+	require.Error(t, ctx.Err)
+}